@@ -0,0 +1,88 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// The benchmarks in this file measure building a Scanner for the builtin
+// licenses and scanning inputs of various sizes with it, to give later
+// performance work a fixed baseline. Compare runs with benchstat:
+//
+//	go test -run=NONE -bench='NewScanner|ScanSize' -count=10 > old.txt
+//	(make changes)
+//	go test -run=NONE -bench='NewScanner|ScanSize' -count=10 > new.txt
+//	benchstat old.txt new.txt
+
+func BenchmarkNewScanner(b *testing.B) {
+	licenses := BuiltinLicenses()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewScanner(licenses); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// largeBenchSize is the size of the large input for BenchmarkScanSize.
+const largeBenchSize = 1 << 20
+
+func BenchmarkScanSize(b *testing.B) {
+	body := func(file string) []byte {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", file))
+		if err != nil {
+			b.Fatal(err)
+		}
+		if i := bytes.Index(data, []byte("\n\n")); i >= 0 {
+			data = data[i+2:]
+		}
+		return data
+	}
+	source, err := ioutil.ReadFile("scan.go")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// The large input is test data files, in sorted order,
+	// up to largeBenchSize bytes: a mix of licenses and other text,
+	// like a concatenated notices file.
+	files, err := filepath.Glob("testdata/*.t*")
+	if err != nil {
+		b.Fatal(err)
+	}
+	sort.Strings(files)
+	var large []byte
+	for _, file := range files {
+		if len(large) >= largeBenchSize {
+			break
+		}
+		large = append(large, body(filepath.Base(file))...)
+	}
+
+	Scan(nil) // build builtin scanner outside timing
+	for _, bm := range []struct {
+		name string
+		text []byte
+	}{
+		{"Header", body("Apache-2.0-Header.t1")}, // short license header
+		{"Source", source},                       // source file without a license
+		{"License", body("Apache-2.0.t1")},       // full license text
+		{"LongLicense", body("GPL-3.0.t1")},      // long license text
+		{"Large", large},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.SetBytes(int64(len(bm.text)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				Scan(bm.text)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/licensecheck/internal/match"
+)
+
+// ByLicense returns the matches in c grouped by license ID.
+// Each list of matches is sorted by position in the text.
+func (c Coverage) ByLicense() map[string][]Match {
+	m := make(map[string][]Match)
+	for _, match := range c.Match {
+		m[match.ID] = append(m[match.ID], match)
+	}
+	for _, list := range m {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].Start < list[j].Start
+		})
+	}
+	return m
+}
+
+// ids returns the distinct license IDs in c.Match,
+// in order of their first appearance in the text.
+func (c Coverage) ids() []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, m := range c.Match {
+		if !seen[m.ID] {
+			seen[m.ID] = true
+			ids = append(ids, m.ID)
+		}
+	}
+	return ids
+}
+
+// SPDXExpression returns an SPDX license expression describing c.
+// Since the text is subject to every license found in it, the expression
+// is the conjunction (AND) of the distinct license IDs in c.Match,
+// in order of first appearance: for example, "MIT AND Apache-2.0".
+// A license ID that licensecheck defines for a choice between licenses,
+// such as Perl (see licenses/README.md), is written as the equivalent
+// disjunction (OR), parenthesized if there are other IDs:
+// for example, "MIT AND (Artistic-1.0-Perl OR GPL-1.0-or-later)".
+// If c has no matches, SPDXExpression returns an empty string.
+func (c Coverage) SPDXExpression() string {
+	return spdxExpression(c.ids())
+}
+
+// spdxExpression returns the conjunction of the license IDs in ids,
+// writing any ID in orExpressions as its disjunction.
+// An entry of ids can also be a disjunction already, such as "MIT OR Apache-2.0".
+// It overwrites ids.
+func spdxExpression(ids []string) string {
+	for i, id := range ids {
+		expr := id
+		if or, ok := orExpressions[id]; ok {
+			expr = or
+		}
+		if len(ids) > 1 && strings.Contains(expr, " OR ") && !isParenthesized(expr) {
+			expr = "(" + expr + ")"
+		}
+		ids[i] = expr
+	}
+	return strings.Join(ids, " AND ")
+}
+
+// orExpressions maps the license IDs that licensecheck defines
+// for a choice between SPDX licenses to the equivalent SPDX expressions.
+var orExpressions = map[string]string{
+	"GPL-2.0-or-3.0": "GPL-2.0-only OR GPL-3.0-only",
+	"Perl":           "Artistic-1.0-Perl OR GPL-1.0-or-later",
+}
+
+// Has reports whether c contains a match for the license with the given ID.
+func (c Coverage) Has(id string) bool {
+	for _, m := range c.Match {
+		if m.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasType reports whether c contains a match for a license
+// whose Type includes all the bits set in t.
+// For example, HasType(ShareProgram) reports whether any match
+// requires sharing the source code of the entire program.
+// As a special case, HasType(Unknown) reports whether any match
+// has Type Unknown.
+func (c Coverage) HasType(t Type) bool {
+	for _, m := range c.Match {
+		if t == Unknown && m.Type == Unknown || t != Unknown && m.Type&t == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Dominant returns the license accounting for the largest share of
+// the words matched in c, adding up all the matches for each license,
+// along with the percentage of the total text that license covers.
+// Unlike looking for the single largest match, Dominant answers
+// "what license is this text?" even when a license appears in pieces.
+// Ties are broken in favor of the smaller ID.
+// Dominant counts words using Match.Words, as set by Scan.
+// If c has no matches, Dominant returns "", 0.
+func (c Coverage) Dominant() (id string, percent float64) {
+	words := make(map[string]int)
+	total := 0
+	for _, m := range c.Match {
+		words[m.ID] += m.Words
+		total += m.Words
+	}
+	best := -1
+	for mid, n := range words {
+		if n > best || n == best && mid < id {
+			id, best = mid, n
+		}
+	}
+	if total > 0 {
+		percent = c.Percent * float64(best) / float64(total)
+	}
+	return id, percent
+}
+
+// Coalesce returns a copy of c in which adjacent matches of the same license
+// separated by at most maxGap normalized words of unmatched text
+// are merged into a single match, so that a license whose text has been
+// interrupted, for example by an inserted paragraph, is reported once.
+// A coalesced match spans from the start of its first piece to the end
+// of its last, but its coverage is the union of the pieces: Words counts
+// only the matched words, not the gaps, so Percent is unchanged.
+// The coalesced match is Complete if any piece is, and it has
+// IsURL, IsNotice, IsReference, IsGrant, or IsNoticeFile set only if every piece does.
+// It is TruncatedAtEnd if its last piece is.
+// Its Sections, CopyrightYears, and UnfilledPlaceholders combine those
+// of all the pieces, without duplicates, and its Rider is the first
+// Rider that any piece has.
+//
+// Coalesce uses word positions recorded by Scan, so it never merges
+// matches from other sources, such as ScanSPDXTags.
+// A maxGap of 0 merges only matches with no words between them.
+func (c Coverage) Coalesce(maxGap int) Coverage {
+	var list []Match
+	for _, m := range c.Match {
+		if n := len(list); n > 0 {
+			p := &list[n-1]
+			if p.ID == m.ID && p.wordEnd > p.wordStart && m.wordEnd > m.wordStart &&
+				m.wordStart >= p.wordEnd && m.wordStart-p.wordEnd <= maxGap {
+				p.End = m.End
+				p.RuneEnd = m.RuneEnd
+				p.Words += m.Words
+				p.wordEnd = m.wordEnd
+				p.Complete = p.Complete || m.Complete
+				p.IsURL = p.IsURL && m.IsURL
+				p.IsNotice = p.IsNotice && m.IsNotice
+				p.IsReference = p.IsReference && m.IsReference
+				p.IsGrant = p.IsGrant && m.IsGrant
+				p.IsNoticeFile = p.IsNoticeFile && m.IsNoticeFile
+				p.TruncatedAtEnd = m.TruncatedAtEnd
+				p.Sections = unionStrings(p.Sections, m.Sections)
+				p.CopyrightYears = unionYears(p.CopyrightYears, m.CopyrightYears)
+				p.UnfilledPlaceholders = unionStrings(p.UnfilledPlaceholders, m.UnfilledPlaceholders)
+				if p.Rider == "" {
+					p.Rider = m.Rider
+				}
+				continue
+			}
+		}
+		list = append(list, m)
+	}
+	c.Match = list
+	return c
+}
+
+// unionStrings returns a new list holding the strings in x
+// followed by those in y that are not in x, or nil if both are empty.
+func unionStrings(x, y []string) []string {
+	var list []string
+	seen := make(map[string]bool)
+	for _, s := range append(x[:len(x):len(x)], y...) {
+		if !seen[s] {
+			seen[s] = true
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// unionYears returns a new list holding, in increasing order
+// and without duplicates, the years in x and y, or nil if both are empty.
+func unionYears(x, y []int) []int {
+	var list []int
+	seen := make(map[int]bool)
+	for _, year := range append(x[:len(x):len(x)], y...) {
+		if !seen[year] {
+			seen[year] = true
+			list = append(list, year)
+		}
+	}
+	sort.Ints(list)
+	return list
+}
+
+// NormalizedHash returns a hash of the text of the match m,
+// which must be one of c's matches, for recognizing copies of the same
+// license text across many inputs. The hash is computed over the matched
+// text's normalized words, the same words that Scan matches against
+// license patterns: the text is case-folded and split into words, and
+// spacing, punctuation, comment markers, and markup are dropped.
+// Copies of a license that differ only in those respects hash the same.
+//
+// The hash is the SHA-256 digest of the normalized words, each followed
+// by a single space, written as 64 lowercase hexadecimal digits.
+// It does not depend on the Scanner or its options and is stable across
+// releases of this package, but any change to the matched words,
+// including to the copyright line at the start of a match, changes it.
+//
+// NormalizedHash reads the text that was scanned to produce c,
+// so that text must not be modified before calling NormalizedHash.
+// If c was not produced by Scan, or m does not lie within
+// the scanned text, NormalizedHash returns an empty string.
+func (c Coverage) NormalizedHash(m Match) string {
+	if c.text == nil || m.Start < 0 || m.Start > m.End || m.End > len(c.text) {
+		return ""
+	}
+	var d match.Dict
+	words := d.InsertSplit(string(c.text[m.Start:m.End]))
+	list := d.Words()
+	h := sha256.New()
+	for _, w := range words {
+		h.Write([]byte(list[w.ID]))
+		h.Write([]byte{' '})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Snippet returns an excerpt of text, which must be the text that was
+// scanned to produce c, showing the match m in context: the text of the
+// match, text[m.Start:m.End], together with up to radius words of the text
+// before and after it, as in a report of findings. Here a word is a run of
+// text not containing Unicode spacing. The excerpt is taken verbatim from
+// text, including line breaks; it is cut short where the text begins or ends.
+// A radius <= 0 returns the text of the match alone.
+// If m does not lie within text, Snippet returns an empty string.
+func (c Coverage) Snippet(text []byte, m Match, radius int) string {
+	if m.Start < 0 || m.Start > m.End || m.End > len(text) {
+		return ""
+	}
+	notSpace := func(r rune) bool { return !unicode.IsSpace(r) }
+	lo, hi := m.Start, m.End
+	for i := 0; i < radius; i++ {
+		j := bytes.LastIndexFunc(text[:lo], notSpace)
+		if j < 0 {
+			break
+		}
+		k := bytes.LastIndexFunc(text[:j], unicode.IsSpace)
+		if k < 0 {
+			lo = 0
+			break
+		}
+		_, size := utf8.DecodeRune(text[k:])
+		lo = k + size
+	}
+	for i := 0; i < radius; i++ {
+		j := bytes.IndexFunc(text[hi:], notSpace)
+		if j < 0 {
+			break
+		}
+		j += hi
+		k := bytes.IndexFunc(text[j:], unicode.IsSpace)
+		if k < 0 {
+			hi = len(text)
+			break
+		}
+		hi = j + k
+	}
+	return string(text[lo:hi])
+}
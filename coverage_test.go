@@ -0,0 +1,254 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testCoverage = Coverage{
+	Percent: 90,
+	Match: []Match{
+		{ID: "MIT", Type: Notice, Start: 0, End: 100, Words: 40},
+		{ID: "Apache-2.0", Type: Notice, Start: 100, End: 200, Words: 100},
+		{ID: "MIT", Type: Notice, Start: 250, End: 350, Words: 70},
+		{ID: "GPL-2.0", Type: ShareProgram, Start: 400, End: 410, Words: 10, IsURL: true},
+	},
+}
+
+func TestByLicense(t *testing.T) {
+	have := testCoverage.ByLicense()
+	want := map[string][]Match{
+		"MIT":        {testCoverage.Match[0], testCoverage.Match[2]},
+		"Apache-2.0": {testCoverage.Match[1]},
+		"GPL-2.0":    {testCoverage.Match[3]},
+	}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("ByLicense() = %v, want %v", have, want)
+	}
+
+	if have := (Coverage{}).ByLicense(); len(have) != 0 {
+		t.Errorf("Coverage{}.ByLicense() = %v, want empty map", have)
+	}
+}
+
+func TestSPDXExpression(t *testing.T) {
+	if have, want := testCoverage.SPDXExpression(), "MIT AND Apache-2.0 AND GPL-2.0"; have != want {
+		t.Errorf("SPDXExpression() = %q, want %q", have, want)
+	}
+	if have := (Coverage{}).SPDXExpression(); have != "" {
+		t.Errorf("Coverage{}.SPDXExpression() = %q, want empty string", have)
+	}
+
+	perl := Coverage{Match: []Match{{ID: "Perl"}}}
+	if have, want := perl.SPDXExpression(), "Artistic-1.0-Perl OR GPL-1.0-or-later"; have != want {
+		t.Errorf("SPDXExpression(Perl) = %q, want %q", have, want)
+	}
+	perl.Match = append(perl.Match, Match{ID: "MIT"})
+	if have, want := perl.SPDXExpression(), "(Artistic-1.0-Perl OR GPL-1.0-or-later) AND MIT"; have != want {
+		t.Errorf("SPDXExpression(Perl, MIT) = %q, want %q", have, want)
+	}
+}
+
+func TestHas(t *testing.T) {
+	for _, id := range []string{"MIT", "Apache-2.0", "GPL-2.0"} {
+		if !testCoverage.Has(id) {
+			t.Errorf("Has(%q) = false, want true", id)
+		}
+	}
+	if testCoverage.Has("BSD-3-Clause") {
+		t.Errorf("Has(%q) = true, want false", "BSD-3-Clause")
+	}
+	if (Coverage{}).Has("MIT") {
+		t.Errorf("Coverage{}.Has(%q) = true, want false", "MIT")
+	}
+}
+
+var hasTypeTests = []struct {
+	t   Type
+	out bool
+}{
+	{Notice, true},
+	{ShareProgram, true},
+	{ShareServer, false},
+	{Notice | ShareProgram, false},
+	{Unknown, false},
+}
+
+func TestHasType(t *testing.T) {
+	for _, tt := range hasTypeTests {
+		if out := testCoverage.HasType(tt.t); out != tt.out {
+			t.Errorf("HasType(%v) = %v, want %v", tt.t, out, tt.out)
+		}
+	}
+
+	c := Coverage{Match: []Match{{ID: "X", Type: Notice | NonCommercial}, {ID: "Y"}}}
+	for _, typ := range []Type{Notice, NonCommercial, Notice | NonCommercial, Unknown} {
+		if !c.HasType(typ) {
+			t.Errorf("HasType(%v) = false, want true", typ)
+		}
+	}
+	if (Coverage{}).HasType(Unknown) || (Coverage{}).HasType(Notice) {
+		t.Errorf("Coverage{}.HasType returned true, want false")
+	}
+}
+
+func TestDominant(t *testing.T) {
+	// MIT covers 40+70 of the 220 matched words, more than Apache-2.0's 100.
+	if id, percent := testCoverage.Dominant(); id != "MIT" || percent != 45 {
+		t.Errorf("Dominant() = %q, %v, want %q, 45", id, percent, "MIT")
+	}
+
+	tie := Coverage{
+		Percent: 100,
+		Match: []Match{
+			{ID: "MIT", Words: 50},
+			{ID: "BSD-2-Clause", Words: 50},
+		},
+	}
+	if id, percent := tie.Dominant(); id != "BSD-2-Clause" || percent != 50 {
+		t.Errorf("Dominant() with tie = %q, %v, want %q, 50", id, percent, "BSD-2-Clause")
+	}
+
+	if id, percent := (Coverage{}).Dominant(); id != "" || percent != 0 {
+		t.Errorf("Coverage{}.Dominant() = %q, %v, want \"\", 0", id, percent)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT"})
+	text := []byte(license_MIT + "\nThis paragraph was inserted by a packager.\n\n" + license_MIT)
+	cov := s.Scan(text)
+	if len(cov.Match) != 2 {
+		t.Fatalf("Scan: %d matches, want 2", len(cov.Match))
+	}
+
+	// The gap holds the inserted paragraph and the second copyright line.
+	m0, m1 := cov.Match[0], cov.Match[1]
+	gap := m1.wordStart - m0.wordEnd
+	if gap <= 7 {
+		t.Fatalf("gap between matches = %d words, want > 7", gap)
+	}
+	if have := cov.Coalesce(gap - 1); !reflect.DeepEqual(have, cov) {
+		t.Errorf("Coalesce(gap-1) = %v, want unchanged %v", have, cov)
+	}
+
+	have := cov.Coalesce(gap)
+	want := m0
+	want.End, want.RuneEnd = m1.End, m1.RuneEnd
+	want.Words = m0.Words + m1.Words
+	want.wordEnd = m1.wordEnd
+	if len(have.Match) != 1 || !reflect.DeepEqual(have.Match[0], want) {
+		t.Errorf("Coalesce(gap).Match = %+v, want [%+v]", have.Match, want)
+	}
+	if have.Percent != cov.Percent {
+		t.Errorf("Coalesce(gap).Percent = %.1f, want %.1f", have.Percent, cov.Percent)
+	}
+
+	// The details of the pieces are combined.
+	m0.CopyrightYears, m1.CopyrightYears = []int{2015, 2019}, []int{2017, 2019}
+	m0.Sections, m1.Sections = []string{"Preamble", "0"}, []string{"0", "1"}
+	m0.UnfilledPlaceholders, m1.UnfilledPlaceholders = nil, []string{"[yyyy]"}
+	m0.Rider, m1.Rider = "", "CommonsClause"
+	have = Coverage{Percent: cov.Percent, Match: []Match{m0, m1}}.Coalesce(gap)
+	if len(have.Match) != 1 {
+		t.Fatalf("Coalesce(gap) with details: %d matches, want 1", len(have.Match))
+	}
+	h := have.Match[0]
+	if want := []int{2015, 2017, 2019}; !reflect.DeepEqual(h.CopyrightYears, want) {
+		t.Errorf("Coalesce(gap).CopyrightYears = %v, want %v", h.CopyrightYears, want)
+	}
+	if want := []string{"Preamble", "0", "1"}; !reflect.DeepEqual(h.Sections, want) {
+		t.Errorf("Coalesce(gap).Sections = %v, want %v", h.Sections, want)
+	}
+	if want := []string{"[yyyy]"}; !reflect.DeepEqual(h.UnfilledPlaceholders, want) {
+		t.Errorf("Coalesce(gap).UnfilledPlaceholders = %v, want %v", h.UnfilledPlaceholders, want)
+	}
+	if h.Rider != "CommonsClause" {
+		t.Errorf("Coalesce(gap).Rider = %q, want %q", h.Rider, "CommonsClause")
+	}
+	if want := []int{2015, 2019}; !reflect.DeepEqual(m0.CopyrightYears, want) {
+		t.Errorf("Coalesce(gap) modified the first piece's CopyrightYears to %v", m0.CopyrightYears)
+	}
+
+	// Matches not produced by Scan have no word positions and are never merged.
+	if have := testCoverage.Coalesce(1000); !reflect.DeepEqual(have, testCoverage) {
+		t.Errorf("testCoverage.Coalesce(1000) = %v, want unchanged", have)
+	}
+}
+
+func TestNormalizedHash(t *testing.T) {
+	hash := func(text string) string {
+		t.Helper()
+		cov := Scan([]byte(text))
+		if len(cov.Match) != 1 {
+			t.Fatalf("Scan(%.40q...): Match=%v, want one match", text, cov.Match)
+		}
+		return cov.NormalizedHash(cov.Match[0])
+	}
+
+	h := hash(license_MIT)
+	if len(h) != 64 || strings.Trim(h, "0123456789abcdef") != "" {
+		t.Errorf("NormalizedHash = %q, want 64 hex digits", h)
+	}
+
+	// Reformatted as a comment, with rewrapped lines and changed punctuation.
+	reformatted := "/*\n * " + strings.ReplaceAll(strings.ReplaceAll(license_MIT, "\n", "\n * "), ",", " ;") + "\n */\n"
+	reformatted = strings.ReplaceAll(reformatted, "Permission is", "PERMISSION\n * is")
+	if h2 := hash(reformatted); h2 != h {
+		t.Errorf("NormalizedHash(reformatted) = %s, want %s", h2, h)
+	}
+
+	// A different copyright holder changes the matched words.
+	other := strings.Replace(license_MIT, "the right gopher", "someone else", 1)
+	if other == license_MIT {
+		t.Fatal("copyright holder not found in license_MIT")
+	}
+	if h2 := hash(other); h2 == h {
+		t.Errorf("NormalizedHash(other holder) = %s, same as original", h2)
+	}
+
+	if h := (Coverage{}).NormalizedHash(Match{ID: "MIT", End: 10}); h != "" {
+		t.Errorf("Coverage{}.NormalizedHash = %q, want empty string", h)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	text := []byte("one two  three\nMATCH HERE\tfour five six")
+	start := bytes.Index(text, []byte("MATCH"))
+	m := Match{ID: "X", Start: start, End: start + len("MATCH HERE")}
+	var c Coverage
+	tests := []struct {
+		radius int
+		want   string
+	}{
+		{-1, "MATCH HERE"},
+		{0, "MATCH HERE"},
+		{1, "three\nMATCH HERE\tfour"},
+		{2, "two  three\nMATCH HERE\tfour five"},
+		{3, "one two  three\nMATCH HERE\tfour five six"},
+		{10, "one two  three\nMATCH HERE\tfour five six"},
+	}
+	for _, tt := range tests {
+		if have := c.Snippet(text, m, tt.radius); have != tt.want {
+			t.Errorf("Snippet(radius=%d) = %q, want %q", tt.radius, have, tt.want)
+		}
+	}
+
+	// Matches at the very start and end of the text.
+	if have := c.Snippet(text, Match{Start: 0, End: 3}, 1); have != "one two" {
+		t.Errorf("Snippet(start) = %q, want %q", have, "one two")
+	}
+	if have := c.Snippet(text, Match{Start: len(text) - 3, End: len(text)}, 1); have != "five six" {
+		t.Errorf("Snippet(end) = %q, want %q", have, "five six")
+	}
+
+	if have := c.Snippet(text, Match{Start: 5, End: len(text) + 1}, 1); have != "" {
+		t.Errorf("Snippet(out of range) = %q, want empty string", have)
+	}
+}
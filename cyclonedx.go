@@ -0,0 +1,55 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+// A CycloneDXLicense describes one license found in a Coverage,
+// in the form of an entry in the licenses list of a CycloneDX component
+// or of its license evidence. Its JSON encoding uses the CycloneDX field
+// names, so that an SBOM generator can marshal it directly into the
+// "license" object of a list entry, or into an "expression" entry
+// when Expression is set.
+type CycloneDXLicense struct {
+	ID         string  `json:"id,omitempty"`         // SPDX license ID, unless Expression is set
+	Name       string  `json:"name,omitempty"`       // license name, only for a license with no ID
+	Expression string  `json:"expression,omitempty"` // SPDX expression, for a choice between licenses
+	Confidence float64 `json:"confidence"`           // share of the text covered by the license, from 0 to 1
+}
+
+// CycloneDXLicenses returns a CycloneDXLicense for each distinct license ID
+// in c.Match, in order of first appearance in the text.
+// A CycloneDX license object holds an id or a name but not both,
+// so every entry has its ID set and its Name left empty, and the entries
+// validate against the schema as they are; a generator wanting the full name
+// can look it up with Scanner.LicenseName.
+// A license ID that licensecheck defines for a choice between licenses,
+// such as Perl (see licenses/README.md), is reported as the equivalent
+// SPDX Expression instead of an ID, as in Coverage.SPDXExpression.
+//
+// The Confidence is the fraction of the text that the license's matches
+// cover, as computed by Coverage.Dominant, so a license text alone in a file
+// has a Confidence near 1, while a short notice at the top of
+// a long source file has a much lower one.
+// Like Dominant, CycloneDXLicenses counts words using Match.Words, as set by Scan.
+// If c has no matches, CycloneDXLicenses returns nil.
+func (c Coverage) CycloneDXLicenses() []CycloneDXLicense {
+	words := make(map[string]int)
+	total := 0
+	for _, m := range c.Match {
+		words[m.ID] += m.Words
+		total += m.Words
+	}
+	var list []CycloneDXLicense
+	for _, id := range c.ids() {
+		l := CycloneDXLicense{ID: id}
+		if expr, ok := orExpressions[id]; ok {
+			l.ID, l.Expression = "", expr
+		}
+		if total > 0 {
+			l.Confidence = c.Percent / 100 * float64(words[id]) / float64(total)
+		}
+		list = append(list, l)
+	}
+	return list
+}
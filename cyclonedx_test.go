@@ -0,0 +1,48 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCycloneDXLicenses(t *testing.T) {
+	if list := (Coverage{}).CycloneDXLicenses(); list != nil {
+		t.Errorf("empty Coverage: CycloneDXLicenses() = %v, want nil", list)
+	}
+
+	c := Coverage{
+		Percent: 80,
+		Match: []Match{
+			{ID: "MIT", Words: 30},
+			{ID: "Perl", Words: 50},
+			{ID: "MIT", Words: 20},
+		},
+	}
+	want := []CycloneDXLicense{
+		{ID: "MIT", Confidence: 0.4},
+		{Expression: "Artistic-1.0-Perl OR GPL-1.0-or-later", Confidence: 0.4},
+	}
+	list := c.CycloneDXLicenses()
+	if !reflect.DeepEqual(list, want) {
+		t.Fatalf("CycloneDXLicenses() = %+v, want %+v", list, want)
+	}
+	for _, l := range list {
+		if l.ID != "" && l.Name != "" {
+			t.Errorf("CycloneDXLicenses() entry %+v has both ID and Name; the schema allows only one", l)
+		}
+	}
+
+	js, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const wantJSON = `[{"id":"MIT","confidence":0.4},{"expression":"Artistic-1.0-Perl OR GPL-1.0-or-later","confidence":0.4}]`
+	if string(js) != wantJSON {
+		t.Errorf("json.Marshal(CycloneDXLicenses()) = %s, want %s", js, wantJSON)
+	}
+}
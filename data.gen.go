@@ -3,88 +3,101 @@
 package licensecheck
 
 var builtinLREs = []License{
-	{ID: "0BSD", LRE: license_0BSD_lre},
-	{ID: "AAL", LRE: license_AAL_lre},
-	{ID: "ADSL", LRE: license_ADSL_lre},
-	{ID: "AFL-1.1", LRE: license_AFL_1_1_lre},
-	{ID: "AFL-1.2", LRE: license_AFL_1_2_lre},
-	{ID: "AFL-2.0", LRE: license_AFL_2_0_lre},
-	{ID: "AFL-2.1", LRE: license_AFL_2_1_lre},
-	{ID: "AFL-3.0", LRE: license_AFL_3_0_lre},
-	{ID: "AGPL-1.0", LRE: license_AGPL_1_0_lre},
-	{ID: "AGPL-1.0-only", LRE: license_AGPL_1_0_only_lre},
-	{ID: "AGPL-1.0-or-later", LRE: license_AGPL_1_0_or_later_lre},
-	{ID: "AGPL-3.0", LRE: license_AGPL_3_0_lre},
-	{ID: "AGPL-3.0-only", LRE: license_AGPL_3_0_only_lre},
-	{ID: "AGPL-3.0-or-later", LRE: license_AGPL_3_0_or_later_lre},
-	{ID: "AMDPLPA", LRE: license_AMDPLPA_lre},
-	{ID: "AML", LRE: license_AML_lre},
-	{ID: "AMPAS", LRE: license_AMPAS_lre},
-	{ID: "ANTLR-PD", LRE: license_ANTLR_PD_lre},
-	{ID: "APAFML", LRE: license_APAFML_lre},
-	{ID: "APL-1.0", LRE: license_APL_1_0_lre},
-	{ID: "APSL-1.0", LRE: license_APSL_1_0_lre},
-	{ID: "APSL-1.1", LRE: license_APSL_1_1_lre},
-	{ID: "APSL-1.2", LRE: license_APSL_1_2_lre},
-	{ID: "APSL-2.0", LRE: license_APSL_2_0_lre},
-	{ID: "Abstyles", LRE: license_Abstyles_lre},
-	{ID: "Adobe-2006", LRE: license_Adobe_2006_lre},
-	{ID: "Adobe-Glyph", LRE: license_Adobe_Glyph_lre},
-	{ID: "Afmparse", LRE: license_Afmparse_lre},
-	{ID: "Aladdin", LRE: license_Aladdin_lre},
+	{ID: "0BSD", Name: "BSD Zero Clause License", OSIApproved: true, LRE: license_0BSD_lre},
+	{ID: "AAL", Name: "Attribution Assurance License", OSIApproved: true, LRE: license_AAL_lre},
+	{ID: "ADSL", Name: "Amazon Digital Services License", LRE: license_ADSL_lre},
+	{ID: "AFL-1.1", Name: "Academic Free License v1.1", OSIApproved: true, LRE: license_AFL_1_1_lre},
+	{ID: "AFL-1.1", IsNotice: true, LRE: license_AFL_1_1_Notice_lre},
+	{ID: "AFL-1.2", Name: "Academic Free License v1.2", OSIApproved: true, LRE: license_AFL_1_2_lre},
+	{ID: "AFL-1.2", IsNotice: true, LRE: license_AFL_1_2_Notice_lre},
+	{ID: "AFL-2.0", Name: "Academic Free License v2.0", OSIApproved: true, LRE: license_AFL_2_0_lre},
+	{ID: "AFL-2.0", IsNotice: true, LRE: license_AFL_2_0_Notice_lre},
+	{ID: "AFL-2.1", Name: "Academic Free License v2.1", OSIApproved: true, LRE: license_AFL_2_1_lre},
+	{ID: "AFL-2.1", IsNotice: true, LRE: license_AFL_2_1_Notice_lre},
+	{ID: "AFL-3.0", Name: "Academic Free License v3.0", OSIApproved: true, LRE: license_AFL_3_0_lre},
+	{ID: "AFL-3.0", IsNotice: true, LRE: license_AFL_3_0_Notice_lre},
+	{ID: "AGPL", IsReference: true, LRE: license_AGPL_Family_Reference_lre},
+	{ID: "AGPL-1.0", Name: "Affero General Public License v1.0", LRE: license_AGPL_1_0_lre},
+	{ID: "AGPL-1.0-only", Name: "Affero General Public License v1.0 only", LRE: license_AGPL_1_0_only_lre},
+	{ID: "AGPL-1.0-or-later", Name: "Affero General Public License v1.0 or later", LRE: license_AGPL_1_0_or_later_lre},
+	{ID: "AGPL-3.0", Name: "GNU Affero General Public License v3.0", OSIApproved: true, LRE: license_AGPL_3_0_lre},
+	{ID: "AGPL-3.0", IsNotice: true, LRE: license_AGPL_3_0_Network_lre},
+	{ID: "AGPL-3.0", IsReference: true, LRE: license_AGPL_3_0_Reference_lre},
+	{ID: "AGPL-3.0-only", Name: "GNU Affero General Public License v3.0 only", OSIApproved: true, LRE: license_AGPL_3_0_only_lre},
+	{ID: "AGPL-3.0-or-later", Name: "GNU Affero General Public License v3.0 or later", OSIApproved: true, LRE: license_AGPL_3_0_or_later_lre},
+	{ID: "AMDPLPA", Name: "AMD's plpa_map.c License", LRE: license_AMDPLPA_lre},
+	{ID: "AML", Name: "Apple MIT License", LRE: license_AML_lre},
+	{ID: "AMPAS", Name: "Academy of Motion Picture Arts and Sciences BSD", LRE: license_AMPAS_lre},
+	{ID: "ANTLR-PD", Name: "ANTLR Software Rights Notice", LRE: license_ANTLR_PD_lre},
+	{ID: "APAFML", Name: "Adobe Postscript AFM License", LRE: license_APAFML_lre},
+	{ID: "APL-1.0", Name: "Adaptive Public License 1.0", OSIApproved: true, LRE: license_APL_1_0_lre},
+	{ID: "APSL-1.0", Name: "Apple Public Source License 1.0", OSIApproved: true, LRE: license_APSL_1_0_lre},
+	{ID: "APSL-1.1", Name: "Apple Public Source License 1.1", OSIApproved: true, LRE: license_APSL_1_1_lre},
+	{ID: "APSL-1.2", Name: "Apple Public Source License 1.2", OSIApproved: true, LRE: license_APSL_1_2_lre},
+	{ID: "APSL-2.0", Name: "Apple Public Source License 2.0", OSIApproved: true, LRE: license_APSL_2_0_lre},
+	{ID: "Abstyles", Name: "Abstyles License", LRE: license_Abstyles_lre},
+	{ID: "Adobe-2006", Name: "Adobe Systems Incorporated Source Code License Agreement", LRE: license_Adobe_2006_lre},
+	{ID: "Adobe-Glyph", Name: "Adobe Glyph List License", LRE: license_Adobe_Glyph_lre},
+	{ID: "Afmparse", Name: "Afmparse License", LRE: license_Afmparse_lre},
+	{ID: "Aladdin", Name: "Aladdin Free Public License", LRE: license_Aladdin_lre},
 	{ID: "Aladdin-9", LRE: license_Aladdin_9_lre},
-	{ID: "Anti996", LRE: license_Anti996_lre},
-	{ID: "Apache-1.0", LRE: license_Apache_1_0_lre},
-	{ID: "Apache-1.1", LRE: license_Apache_1_1_lre},
-	{ID: "Apache-2.0", LRE: license_Apache_2_0_lre},
-	{ID: "Artistic-1.0", LRE: license_Artistic_1_0_lre},
-	{ID: "Artistic-1.0-Perl", LRE: license_Artistic_1_0_Perl_lre},
-	{ID: "Artistic-1.0-cl8", LRE: license_Artistic_1_0_cl8_lre},
-	{ID: "Artistic-2.0", LRE: license_Artistic_2_0_lre},
-	{ID: "BSD-1-Clause", LRE: license_BSD_1_Clause_lre},
+	{ID: "Anti996", Name: "Anti-996 License.", LRE: license_Anti996_lre},
+	{ID: "Apache-1.0", Name: "Apache License 1.0", LRE: license_Apache_1_0_lre},
+	{ID: "Apache-1.1", Name: "Apache License 1.1", OSIApproved: true, LRE: license_Apache_1_1_lre},
+	{ID: "Apache-2.0", Name: "Apache License 2.0", OSIApproved: true, LRE: license_Apache_2_0_lre},
+	{ID: "Apache-2.0", IsGrant: true, LRE: license_Apache_2_0_Grant_lre},
+	{ID: "Artistic-1.0", Name: "Artistic License 1.0", OSIApproved: true, LRE: license_Artistic_1_0_lre},
+	{ID: "Artistic-1.0-Perl", Name: "Artistic License 1.0 (Perl)", OSIApproved: true, LRE: license_Artistic_1_0_Perl_lre},
+	{ID: "Artistic-1.0-cl8", Name: "Artistic License 1.0 w/clause 8", OSIApproved: true, LRE: license_Artistic_1_0_cl8_lre},
+	{ID: "Artistic-2.0", Name: "Artistic License 2.0", OSIApproved: true, LRE: license_Artistic_2_0_lre},
+	{ID: "BSD-1-Clause", Name: "BSD 1-Clause License", LRE: license_BSD_1_Clause_lre},
 	{ID: "BSD-1-Clause-Clear", LRE: license_BSD_1_Clause_Clear_lre},
-	{ID: "BSD-2-Clause", LRE: license_BSD_2_Clause_lre},
-	{ID: "BSD-2-Clause-Patent", LRE: license_BSD_2_Clause_Patent_lre},
-	{ID: "BSD-2-Clause-Views", LRE: license_BSD_2_Clause_Views_lre},
-	{ID: "BSD-3-Clause", LRE: license_BSD_3_Clause_lre},
-	{ID: "BSD-3-Clause-Attribution", LRE: license_BSD_3_Clause_Attribution_lre},
-	{ID: "BSD-3-Clause-Clear", LRE: license_BSD_3_Clause_Clear_lre},
-	{ID: "BSD-3-Clause-LBNL", LRE: license_BSD_3_Clause_LBNL_lre},
-	{ID: "BSD-3-Clause-No-Nuclear-License", LRE: license_BSD_3_Clause_No_Nuclear_License_lre},
-	{ID: "BSD-3-Clause-No-Nuclear-License-2014", LRE: license_BSD_3_Clause_No_Nuclear_License_2014_lre},
-	{ID: "BSD-3-Clause-No-Nuclear-Warranty", LRE: license_BSD_3_Clause_No_Nuclear_Warranty_lre},
+	{ID: "BSD-2-Clause", Name: "BSD 2-Clause \"Simplified\" License", OSIApproved: true, LRE: license_BSD_2_Clause_lre},
+	{ID: "BSD-2-Clause", IsGrant: true, LRE: license_BSD_2_Clause_Grant_lre},
+	{ID: "BSD-2-Clause-Patent", Name: "BSD-2-Clause Plus Patent License", OSIApproved: true, LRE: license_BSD_2_Clause_Patent_lre},
+	{ID: "BSD-2-Clause-Views", Name: "BSD 2-Clause Views", LRE: license_BSD_2_Clause_Views_lre},
+	{ID: "BSD-3-Clause", Name: "BSD 3-Clause \"New\" or \"Revised\" License", OSIApproved: true, LRE: license_BSD_3_Clause_lre},
+	{ID: "BSD-3-Clause", IsGrant: true, LRE: license_BSD_3_Clause_Grant_lre},
+	{ID: "BSD-3-Clause-Attribution", Name: "BSD with attribution", LRE: license_BSD_3_Clause_Attribution_lre},
+	{ID: "BSD-3-Clause-Clear", Name: "BSD 3-Clause Clear License", LRE: license_BSD_3_Clause_Clear_lre},
+	{ID: "BSD-3-Clause-LBNL", Name: "Lawrence Berkeley National Labs BSD variant license", OSIApproved: true, LRE: license_BSD_3_Clause_LBNL_lre},
+	{ID: "BSD-3-Clause-No-Nuclear-License", Name: "BSD 3-Clause No Nuclear License", LRE: license_BSD_3_Clause_No_Nuclear_License_lre},
+	{ID: "BSD-3-Clause-No-Nuclear-License-2014", Name: "BSD 3-Clause No Nuclear License 2014", OSIApproved: true, LRE: license_BSD_3_Clause_No_Nuclear_License_2014_lre},
+	{ID: "BSD-3-Clause-No-Nuclear-Warranty", Name: "BSD 3-Clause No Nuclear License", LRE: license_BSD_3_Clause_No_Nuclear_Warranty_lre},
 	{ID: "BSD-3-Clause-NoTrademark", LRE: license_BSD_3_Clause_NoTrademark_lre},
-	{ID: "BSD-3-Clause-Open-MPI", LRE: license_BSD_3_Clause_Open_MPI_lre},
-	{ID: "BSD-4-Clause-UC", LRE: license_BSD_4_Clause_UC_lre},
-	{ID: "BSD-4-Clause", LRE: license_BSD_4_Clause_lre},
-	{ID: "BSD-Protection", LRE: license_BSD_Protection_lre},
-	{ID: "BSD-Source-Code", LRE: license_BSD_Source_Code_lre},
-	{ID: "BSL-1.0", LRE: license_BSL_1_0_lre},
-	{ID: "Bahyph", LRE: license_Bahyph_lre},
-	{ID: "Barr", LRE: license_Barr_lre},
-	{ID: "Beerware", LRE: license_Beerware_lre},
-	{ID: "BitTorrent-1.0", LRE: license_BitTorrent_1_0_lre},
-	{ID: "BitTorrent-1.1", LRE: license_BitTorrent_1_1_lre},
-	{ID: "BlueOak-1.0.0", LRE: license_BlueOak_1_0_0_lre},
-	{ID: "Borceux", LRE: license_Borceux_lre},
-	{ID: "CAL-1.0", LRE: license_CAL_1_0_lre},
-	{ID: "CATOSL-1.1", LRE: license_CATOSL_1_1_lre},
+	{ID: "BSD-3-Clause-Open-MPI", Name: "BSD 3-Clause Open MPI variant", LRE: license_BSD_3_Clause_Open_MPI_lre},
+	{ID: "BSD-4-Clause-UC", Name: "BSD 4-Clause (University of California-Specific)", LRE: license_BSD_4_Clause_UC_lre},
+	{ID: "BSD-4-Clause", Name: "BSD 4-Clause \"Original\" or \"Old\" License", LRE: license_BSD_4_Clause_lre},
+	{ID: "BSD-Protection", Name: "BSD Protection License", LRE: license_BSD_Protection_lre},
+	{ID: "BSD-Source-Code", Name: "BSD 1-Clause License plus non-advertising clause (usual BSD clause #3)", LRE: license_BSD_Source_Code_lre},
+	{ID: "BSL-1.0", Name: "Boost Software License 1.0", OSIApproved: true, LRE: license_BSL_1_0_lre},
+	{ID: "BSL-1.0", IsNotice: true, LRE: license_BSL_1_0_Notice_lre},
+	{ID: "Bahyph", Name: "Bahyph License", LRE: license_Bahyph_lre},
+	{ID: "Barr", Name: "Barr License", LRE: license_Barr_lre},
+	{ID: "Beerware", Name: "Beerware License", LRE: license_Beerware_lre},
+	{ID: "Beerware", IsGrant: true, LRE: license_Beerware_Grant_lre},
+	{ID: "BitTorrent-1.0", Name: "BitTorrent Open Source License v1.0", LRE: license_BitTorrent_1_0_lre},
+	{ID: "BitTorrent-1.1", Name: "BitTorrent Open Source License v1.1", LRE: license_BitTorrent_1_1_lre},
+	{ID: "BlueOak-1.0.0", Name: "Blue Oak Model License 1.0.0", LRE: license_BlueOak_1_0_0_lre},
+	{ID: "Borceux", Name: "Borceux license", LRE: license_Borceux_lre},
+	{ID: "CAL-1.0", Name: "Cryptographic Autonomy License 1.0", OSIApproved: true, LRE: license_CAL_1_0_lre},
+	{ID: "CATOSL-1.1", Name: "Computer Associates Trusted Open Source License 1.1", OSIApproved: true, LRE: license_CATOSL_1_1_lre},
 	{ID: "CC-BY-1.0", LRE: license_CC_BY_1_0_lre},
 	{ID: "CC-BY-2.0", LRE: license_CC_BY_2_0_lre},
 	{ID: "CC-BY-2.5", LRE: license_CC_BY_2_5_lre},
 	{ID: "CC-BY-3.0", LRE: license_CC_BY_3_0_lre},
-	{ID: "CC-BY-3.0-AT", LRE: license_CC_BY_3_0_AT_lre},
+	{ID: "CC-BY-3.0-AT", Name: "Creative Commons Attribution 3.0 Austria", Lang: "de", LRE: license_CC_BY_3_0_AT_lre},
 	{ID: "CC-BY-4.0", LRE: license_CC_BY_4_0_lre},
 	{ID: "CC-BY-NC-1.0", LRE: license_CC_BY_NC_1_0_lre},
 	{ID: "CC-BY-NC-2.0", LRE: license_CC_BY_NC_2_0_lre},
 	{ID: "CC-BY-NC-2.5", LRE: license_CC_BY_NC_2_5_lre},
 	{ID: "CC-BY-NC-3.0", LRE: license_CC_BY_NC_3_0_lre},
 	{ID: "CC-BY-NC-4.0", LRE: license_CC_BY_NC_4_0_lre},
-	{ID: "CC-BY-NC-ND-1.0", LRE: license_CC_BY_NC_ND_1_0_lre},
+	{ID: "CC-BY-NC-ND-1.0", Name: "Creative Commons Attribution Non Commercial No Derivatives 1.0 Generic", LRE: license_CC_BY_NC_ND_1_0_lre},
 	{ID: "CC-BY-NC-ND-2.0", LRE: license_CC_BY_NC_ND_2_0_lre},
 	{ID: "CC-BY-NC-ND-2.5", LRE: license_CC_BY_NC_ND_2_5_lre},
 	{ID: "CC-BY-NC-ND-3.0", LRE: license_CC_BY_NC_ND_3_0_lre},
-	{ID: "CC-BY-NC-ND-3.0-IGO", LRE: license_CC_BY_NC_ND_3_0_IGO_lre},
+	{ID: "CC-BY-NC-ND-3.0-IGO", Name: "Creative Commons Attribution Non Commercial No Derivatives 3.0 IGO", LRE: license_CC_BY_NC_ND_3_0_IGO_lre},
 	{ID: "CC-BY-NC-ND-4.0", LRE: license_CC_BY_NC_ND_4_0_lre},
 	{ID: "CC-BY-NC-SA-1.0", LRE: license_CC_BY_NC_SA_1_0_lre},
 	{ID: "CC-BY-NC-SA-2.0", LRE: license_CC_BY_NC_SA_2_0_lre},
@@ -101,331 +114,376 @@ var builtinLREs = []License{
 	{ID: "CC-BY-SA-2.0", LRE: license_CC_BY_SA_2_0_lre},
 	{ID: "CC-BY-SA-2.5", LRE: license_CC_BY_SA_2_5_lre},
 	{ID: "CC-BY-SA-3.0", LRE: license_CC_BY_SA_3_0_lre},
-	{ID: "CC-BY-SA-3.0-AT", LRE: license_CC_BY_SA_3_0_AT_lre},
+	{ID: "CC-BY-SA-3.0-AT", Name: "Creative Commons Attribution-Share Alike 3.0 Austria", Lang: "de", LRE: license_CC_BY_SA_3_0_AT_lre},
 	{ID: "CC-BY-SA-4.0", LRE: license_CC_BY_SA_4_0_lre},
-	{ID: "CC-PDDC", LRE: license_CC_PDDC_lre},
-	{ID: "CC0-1.0", LRE: license_CC0_1_0_lre},
-	{ID: "CDDL-1.0", LRE: license_CDDL_1_0_lre},
-	{ID: "CDDL-1.1", LRE: license_CDDL_1_1_lre},
-	{ID: "CDLA-Permissive-1.0", LRE: license_CDLA_Permissive_1_0_lre},
-	{ID: "CDLA-Sharing-1.0", LRE: license_CDLA_Sharing_1_0_lre},
-	{ID: "CECILL-1.0", LRE: license_CECILL_1_0_lre},
-	{ID: "CECILL-1.1", LRE: license_CECILL_1_1_lre},
-	{ID: "CECILL-2.0", LRE: license_CECILL_2_0_lre},
-	{ID: "CECILL-2.1", LRE: license_CECILL_2_1_lre},
-	{ID: "CECILL-B", LRE: license_CECILL_B_lre},
-	{ID: "CECILL-C", LRE: license_CECILL_C_lre},
-	{ID: "CERN-OHL-1.1", LRE: license_CERN_OHL_1_1_lre},
-	{ID: "CERN-OHL-1.2", LRE: license_CERN_OHL_1_2_lre},
-	{ID: "CERN-OHL-P-2.0", LRE: license_CERN_OHL_P_2_0_lre},
-	{ID: "CERN-OHL-S-2.0", LRE: license_CERN_OHL_S_2_0_lre},
-	{ID: "CERN-OHL-W-2.0", LRE: license_CERN_OHL_W_2_0_lre},
-	{ID: "CNRI-Jython", LRE: license_CNRI_Jython_lre},
-	{ID: "CNRI-Python", LRE: license_CNRI_Python_lre},
-	{ID: "CNRI-Python-GPL-Compatible", LRE: license_CNRI_Python_GPL_Compatible_lre},
-	{ID: "CPAL-1.0", LRE: license_CPAL_1_0_lre},
-	{ID: "CPL-1.0", LRE: license_CPL_1_0_lre},
-	{ID: "CPOL-1.02", LRE: license_CPOL_1_02_lre},
-	{ID: "CUA-OPL-1.0", LRE: license_CUA_OPL_1_0_lre},
-	{ID: "Caldera", LRE: license_Caldera_lre},
-	{ID: "ClArtistic", LRE: license_ClArtistic_lre},
-	{ID: "CommonsClause", LRE: license_CommonsClause_lre},
-	{ID: "Condor-1.1", LRE: license_Condor_1_1_lre},
-	{ID: "Crossword", LRE: license_Crossword_lre},
-	{ID: "CrystalStacker", LRE: license_CrystalStacker_lre},
-	{ID: "Cube", LRE: license_Cube_lre},
-	{ID: "D-FSL-1.0", LRE: license_D_FSL_1_0_lre},
-	{ID: "DOC", LRE: license_DOC_lre},
-	{ID: "DSDP", LRE: license_DSDP_lre},
-	{ID: "Dotseqn", LRE: license_Dotseqn_lre},
-	{ID: "ECL-1.0", LRE: license_ECL_1_0_lre},
-	{ID: "ECL-2.0", LRE: license_ECL_2_0_lre},
-	{ID: "EFL-1.0", LRE: license_EFL_1_0_lre},
-	{ID: "EFL-2.0", LRE: license_EFL_2_0_lre},
-	{ID: "EPICS", LRE: license_EPICS_lre},
-	{ID: "EPL-1.0", LRE: license_EPL_1_0_lre},
-	{ID: "EPL-2.0", LRE: license_EPL_2_0_lre},
-	{ID: "EUDatagrid", LRE: license_EUDatagrid_lre},
-	{ID: "EUPL-1.0", LRE: license_EUPL_1_0_lre},
-	{ID: "EUPL-1.1", LRE: license_EUPL_1_1_lre},
-	{ID: "EUPL-1.2", LRE: license_EUPL_1_2_lre},
-	{ID: "Entessa", LRE: license_Entessa_lre},
-	{ID: "ErlPL-1.1", LRE: license_ErlPL_1_1_lre},
-	{ID: "Eurosym", LRE: license_Eurosym_lre},
-	{ID: "FSFAP", LRE: license_FSFAP_lre},
-	{ID: "FSFUL", LRE: license_FSFUL_lre},
-	{ID: "FSFULLR", LRE: license_FSFULLR_lre},
-	{ID: "FTL", LRE: license_FTL_lre},
-	{ID: "Fair", LRE: license_Fair_lre},
-	{ID: "Frameworx-1.0", LRE: license_Frameworx_1_0_lre},
-	{ID: "FreeImage", LRE: license_FreeImage_lre},
+	{ID: "CC-PDDC", Name: "Creative Commons Public Domain Dedication and Certification", LRE: license_CC_PDDC_lre},
+	{ID: "CC0-1.0", Name: "Creative Commons Zero v1.0 Universal", LRE: license_CC0_1_0_lre},
+	{ID: "CC0-1.0", IsNotice: true, LRE: license_CC0_1_0_Notice_lre},
+	{ID: "CDDL-1.0", Name: "Common Development and Distribution License 1.0", OSIApproved: true, LRE: license_CDDL_1_0_lre},
+	{ID: "CDDL-1.0", IsNotice: true, LRE: license_CDDL_1_0_Notice_lre},
+	{ID: "CDDL-1.1", Name: "Common Development and Distribution License 1.1", LRE: license_CDDL_1_1_lre},
+	{ID: "CDLA-Permissive-1.0", Name: "Community Data License Agreement Permissive 1.0", LRE: license_CDLA_Permissive_1_0_lre},
+	{ID: "CDLA-Sharing-1.0", Name: "Community Data License Agreement Sharing 1.0", LRE: license_CDLA_Sharing_1_0_lre},
+	{ID: "CECILL-1.0", Name: "CeCILL Free Software License Agreement v1.0", Lang: "fr", LRE: license_CECILL_1_0_lre},
+	{ID: "CECILL-1.1", Name: "CeCILL Free Software License Agreement v1.1", LRE: license_CECILL_1_1_lre},
+	{ID: "CECILL-2.0", Name: "CeCILL Free Software License Agreement v2.0", LRE: license_CECILL_2_0_lre},
+	{ID: "CECILL-2.1", Name: "CeCILL Free Software License Agreement v2.1", OSIApproved: true, LRE: license_CECILL_2_1_lre},
+	{ID: "CECILL-B", Name: "CeCILL-B Free Software License Agreement", LRE: license_CECILL_B_lre},
+	{ID: "CECILL-C", Name: "CeCILL-C Free Software License Agreement", LRE: license_CECILL_C_lre},
+	{ID: "CERN-OHL-1.1", Name: "CERN Open Hardware Licence v1.1", LRE: license_CERN_OHL_1_1_lre},
+	{ID: "CERN-OHL-1.2", Name: "CERN Open Hardware Licence v1.2", LRE: license_CERN_OHL_1_2_lre},
+	{ID: "CERN-OHL-P-2.0", Name: "CERN Open Hardware Licence Version 2 - Permissive", LRE: license_CERN_OHL_P_2_0_lre},
+	{ID: "CERN-OHL-S-2.0", Name: "CERN Open Hardware Licence Version 2 - Strongly Reciprocal", LRE: license_CERN_OHL_S_2_0_lre},
+	{ID: "CERN-OHL-W-2.0", Name: "CERN Open Hardware Licence Version 2 - Weakly Reciprocal", LRE: license_CERN_OHL_W_2_0_lre},
+	{ID: "CNRI-Jython", Name: "CNRI Jython License", LRE: license_CNRI_Jython_lre},
+	{ID: "CNRI-Python", Name: "CNRI Python License", OSIApproved: true, LRE: license_CNRI_Python_lre},
+	{ID: "CNRI-Python-GPL-Compatible", Name: "CNRI Python Open Source GPL Compatible License Agreement", LRE: license_CNRI_Python_GPL_Compatible_lre},
+	{ID: "CPAL-1.0", Name: "Common Public Attribution License 1.0", OSIApproved: true, LRE: license_CPAL_1_0_lre},
+	{ID: "CPL-1.0", Name: "Common Public License 1.0", OSIApproved: true, LRE: license_CPL_1_0_lre},
+	{ID: "CPOL-1.02", Name: "Code Project Open License 1.02", LRE: license_CPOL_1_02_lre},
+	{ID: "CUA-OPL-1.0", Name: "CUA Office Public License v1.0", OSIApproved: true, LRE: license_CUA_OPL_1_0_lre},
+	{ID: "Caldera", Name: "Caldera License", LRE: license_Caldera_lre},
+	{ID: "ClArtistic", Name: "Clarified Artistic License", LRE: license_ClArtistic_lre},
+	{ID: "CommonsClause", Name: "CommonsClause addendum", LRE: license_CommonsClause_lre},
+	{ID: "Condor-1.1", Name: "Condor Public License v1.1", LRE: license_Condor_1_1_lre},
+	{ID: "Crossword", Name: "Crossword License", LRE: license_Crossword_lre},
+	{ID: "CrystalStacker", Name: "CrystalStacker License", LRE: license_CrystalStacker_lre},
+	{ID: "Cube", Name: "Cube License", LRE: license_Cube_lre},
+	{ID: "D-FSL-1.0", Name: "Deutsche Freie Software Lizenz", Lang: "de", LRE: license_D_FSL_1_0_lre},
+	{ID: "DOC", Name: "DOC License", LRE: license_DOC_lre},
+	{ID: "DSDP", Name: "DSDP License", LRE: license_DSDP_lre},
+	{ID: "Dotseqn", Name: "Dotseqn License", LRE: license_Dotseqn_lre},
+	{ID: "ECL-1.0", Name: "Educational Community License v1.0", OSIApproved: true, LRE: license_ECL_1_0_lre},
+	{ID: "ECL-2.0", Name: "Educational Community License v2.0", OSIApproved: true, LRE: license_ECL_2_0_lre},
+	{ID: "EFL-1.0", Name: "Eiffel Forum License v1.0", OSIApproved: true, LRE: license_EFL_1_0_lre},
+	{ID: "EFL-2.0", Name: "Eiffel Forum License v2.0", OSIApproved: true, LRE: license_EFL_2_0_lre},
+	{ID: "EPICS", Name: "EPICS Open License", LRE: license_EPICS_lre},
+	{ID: "EPL-1.0", Name: "Eclipse Public License 1.0", OSIApproved: true, LRE: license_EPL_1_0_lre},
+	{ID: "EPL-1.0", IsNotice: true, LRE: license_EPL_1_0_Notice_lre},
+	{ID: "EPL-2.0", Name: "Eclipse Public License 2.0", OSIApproved: true, LRE: license_EPL_2_0_lre},
+	{ID: "EPL-2.0", IsNotice: true, LRE: license_EPL_2_0_Notice_lre},
+	{ID: "EUDatagrid", Name: "EU DataGrid Software License", OSIApproved: true, LRE: license_EUDatagrid_lre},
+	{ID: "EUPL-1.0", Name: "European Union Public License 1.0", LRE: license_EUPL_1_0_lre},
+	{ID: "EUPL-1.1", Name: "European Union Public License 1.1", OSIApproved: true, LRE: license_EUPL_1_1_lre},
+	{ID: "EUPL-1.1", IsNotice: true, LRE: license_EUPL_1_1_Notice_lre},
+	{ID: "EUPL-1.2", Name: "European Union Public License 1.2", OSIApproved: true, LRE: license_EUPL_1_2_lre},
+	{ID: "EUPL-1.2", IsNotice: true, LRE: license_EUPL_1_2_Notice_lre},
+	{ID: "EUPL-1.2", IsReference: true, Lang: "de", LRE: license_EUPL_1_2_de_lre},
+	{ID: "EUPL-1.2", IsReference: true, Lang: "es", LRE: license_EUPL_1_2_es_lre},
+	{ID: "EUPL-1.2", IsReference: true, Lang: "fr", LRE: license_EUPL_1_2_fr_lre},
+	{ID: "EUPL-1.2", IsReference: true, Lang: "it", LRE: license_EUPL_1_2_it_lre},
+	{ID: "EUPL-1.2", IsReference: true, Lang: "nl", LRE: license_EUPL_1_2_nl_lre},
+	{ID: "EUPL-1.2", IsReference: true, Lang: "pt", LRE: license_EUPL_1_2_pt_lre},
+	{ID: "Entessa", Name: "Entessa Public License v1.0", OSIApproved: true, LRE: license_Entessa_lre},
+	{ID: "ErlPL-1.1", Name: "Erlang Public License v1.1", LRE: license_ErlPL_1_1_lre},
+	{ID: "Eurosym", Name: "Eurosym License", LRE: license_Eurosym_lre},
+	{ID: "FSFAP", Name: "FSF All Permissive License", LRE: license_FSFAP_lre},
+	{ID: "FSFUL", Name: "FSF Unlimited License", LRE: license_FSFUL_lre},
+	{ID: "FSFULLR", Name: "FSF Unlimited License (with License Retention)", LRE: license_FSFULLR_lre},
+	{ID: "FTL", Name: "Freetype Project License", LRE: license_FTL_lre},
+	{ID: "Fair", Name: "Fair License", OSIApproved: true, LRE: license_Fair_lre},
+	{ID: "Frameworx-1.0", Name: "Frameworx Open License 1.0", OSIApproved: true, LRE: license_Frameworx_1_0_lre},
+	{ID: "FreeImage", Name: "FreeImage Public License v1.0", LRE: license_FreeImage_lre},
 	{ID: "GFDL-1.3-no-invariants-or-later", LRE: license_GFDL_1_3_no_invariants_or_later_lre},
 	{ID: "GFDL-1.3-no-invariants-only", LRE: license_GFDL_1_3_no_invariants_only_lre},
 	{ID: "GFDL-1.3-invariants-or-later", LRE: license_GFDL_1_3_invariants_or_later_lre},
 	{ID: "GFDL-1.3-invariants-only", LRE: license_GFDL_1_3_invariants_only_lre},
-	{ID: "GFDL-1.3", LRE: license_GFDL_1_3_lre},
+	{ID: "GFDL-1.3", Name: "GNU Free Documentation License v1.3 or later", LRE: license_GFDL_1_3_lre},
 	{ID: "GFDL-1.2-no-invariants-or-later", LRE: license_GFDL_1_2_no_invariants_or_later_lre},
 	{ID: "GFDL-1.2-no-invariants-only", LRE: license_GFDL_1_2_no_invariants_only_lre},
 	{ID: "GFDL-1.2-invariants-or-later", LRE: license_GFDL_1_2_invariants_or_later_lre},
 	{ID: "GFDL-1.2-invariants-only", LRE: license_GFDL_1_2_invariants_only_lre},
-	{ID: "GFDL-1.2", LRE: license_GFDL_1_2_lre},
+	{ID: "GFDL-1.2", Name: "GNU Free Documentation License v1.2 or later", LRE: license_GFDL_1_2_lre},
 	{ID: "GFDL-1.1-no-invariants-or-later", LRE: license_GFDL_1_1_no_invariants_or_later_lre},
 	{ID: "GFDL-1.1-no-invariants-only", LRE: license_GFDL_1_1_no_invariants_only_lre},
 	{ID: "GFDL-1.1-invariants-or-later", LRE: license_GFDL_1_1_invariants_or_later_lre},
 	{ID: "GFDL-1.1-invariants-only", LRE: license_GFDL_1_1_invariants_only_lre},
-	{ID: "GFDL-1.1", LRE: license_GFDL_1_1_lre},
-	{ID: "GL2PS", LRE: license_GL2PS_lre},
-	{ID: "GLWTPL", LRE: license_GLWTPL_lre},
-	{ID: "GPL-1.0", LRE: license_GPL_1_0_lre},
-	{ID: "GPL-1.0-only", LRE: license_GPL_1_0_only_lre},
-	{ID: "GPL-1.0-or-later", LRE: license_GPL_1_0_or_later_lre},
-	{ID: "GPL-2.0", LRE: license_GPL_2_0_lre},
-	{ID: "GPL-2.0-only", LRE: license_GPL_2_0_only_lre},
-	{ID: "GPL-2.0-or-3.0", LRE: license_GPL_2_0_or_3_0_lre},
-	{ID: "GPL-2.0-or-later", LRE: license_GPL_2_0_or_later_lre},
-	{ID: "GPL-3.0", LRE: license_GPL_3_0_lre},
-	{ID: "GPL-3.0-only", LRE: license_GPL_3_0_only_lre},
-	{ID: "GPL-3.0-or-later", LRE: license_GPL_3_0_or_later_lre},
-	{ID: "Giftware", LRE: license_Giftware_lre},
-	{ID: "Glide", LRE: license_Glide_lre},
-	{ID: "Glulxe", LRE: license_Glulxe_lre},
+	{ID: "GFDL-1.1", Name: "GNU Free Documentation License v1.1 or later", LRE: license_GFDL_1_1_lre},
+	{ID: "GL2PS", Name: "GL2PS License", LRE: license_GL2PS_lre},
+	{ID: "GLWTPL", Name: "Good Luck With That Public License", LRE: license_GLWTPL_lre},
+	{ID: "GPL", IsReference: true, LRE: license_GPL_Family_Reference_lre},
+	{ID: "GPL-1.0", Name: "GNU General Public License v1.0", LRE: license_GPL_1_0_lre},
+	{ID: "GPL-1.0-only", Name: "GNU General Public License v1.0 only", LRE: license_GPL_1_0_only_lre},
+	{ID: "GPL-1.0-or-later", Name: "GNU General Public License v1.0 or later", LRE: license_GPL_1_0_or_later_lre},
+	{ID: "GPL-2.0", Name: "GNU General Public License v2.0", OSIApproved: true, LRE: license_GPL_2_0_lre},
+	{ID: "GPL-2.0", IsReference: true, LRE: license_GPL_2_0_Reference_lre},
+	{ID: "GPL-2.0-HowToApply", Name: "GNU General Public License v2.0, How to Apply These Terms (instructions only)", LRE: license_GPL_2_0_HowToApply_lre},
+	{ID: "GPL-2.0-only", Name: "GNU General Public License v2.0 only", OSIApproved: true, LRE: license_GPL_2_0_only_lre},
+	{ID: "GPL-2.0-or-3.0", OSIApproved: true, LRE: license_GPL_2_0_or_3_0_lre},
+	{ID: "GPL-2.0-or-later", Name: "GNU General Public License v2.0 or later", OSIApproved: true, LRE: license_GPL_2_0_or_later_lre},
+	{ID: "GPL-3.0", Name: "GNU General Public License v3.0", OSIApproved: true, LRE: license_GPL_3_0_lre},
+	{ID: "GPL-3.0", IsReference: true, LRE: license_GPL_3_0_Reference_lre},
+	{ID: "GPL-3.0-HowToApply", Name: "GNU General Public License v3.0, How to Apply These Terms (instructions only)", LRE: license_GPL_3_0_HowToApply_lre},
+	{ID: "GPL-3.0-only", Name: "GNU General Public License v3.0 only", OSIApproved: true, LRE: license_GPL_3_0_only_lre},
+	{ID: "GPL-3.0-or-later", Name: "GNU General Public License v3.0 or later", OSIApproved: true, LRE: license_GPL_3_0_or_later_lre},
+	{ID: "Giftware", Name: "Giftware License", LRE: license_Giftware_lre},
+	{ID: "Glide", Name: "3dfx Glide License", LRE: license_Glide_lre},
+	{ID: "Glulxe", Name: "Glulxe License", LRE: license_Glulxe_lre},
 	{ID: "GooglePatentClause", LRE: license_GooglePatentClause_lre},
 	{ID: "GooglePatentsFile", LRE: license_GooglePatentsFile_lre},
-	{ID: "HPND", LRE: license_HPND_lre},
+	{ID: "HPND", OSIApproved: true, LRE: license_HPND_lre},
 	{ID: "HPND-sell-variant", LRE: license_HPND_sell_variant_lre},
-	{ID: "HaskellReport", LRE: license_HaskellReport_lre},
-	{ID: "Hippocratic-2.1", LRE: license_Hippocratic_2_1_lre},
-	{ID: "IBM-pibs", LRE: license_IBM_pibs_lre},
-	{ID: "ICU", LRE: license_ICU_lre},
-	{ID: "IJG", LRE: license_IJG_lre},
-	{ID: "IPA", LRE: license_IPA_lre},
-	{ID: "IPL-1.0", LRE: license_IPL_1_0_lre},
-	{ID: "ISC", LRE: license_ISC_lre},
-	{ID: "ImageMagick", LRE: license_ImageMagick_lre},
-	{ID: "Imlib2", LRE: license_Imlib2_lre},
-	{ID: "Info-ZIP", LRE: license_Info_ZIP_lre},
-	{ID: "Intel", LRE: license_Intel_lre},
-	{ID: "Intel-ACPI", LRE: license_Intel_ACPI_lre},
-	{ID: "Interbase-1.0", LRE: license_Interbase_1_0_lre},
-	{ID: "JPNIC", LRE: license_JPNIC_lre},
-	{ID: "JSON", LRE: license_JSON_lre},
-	{ID: "JasPer-2.0", LRE: license_JasPer_2_0_lre},
-	{ID: "LAL-1.2", LRE: license_LAL_1_2_lre},
-	{ID: "LAL-1.3", LRE: license_LAL_1_3_lre},
-	{ID: "LGPL-2.0", LRE: license_LGPL_2_0_lre},
-	{ID: "LGPL-2.0-only", LRE: license_LGPL_2_0_only_lre},
-	{ID: "LGPL-2.0-or-later", LRE: license_LGPL_2_0_or_later_lre},
-	{ID: "LGPL-2.1", LRE: license_LGPL_2_1_lre},
-	{ID: "LGPL-2.1-only", LRE: license_LGPL_2_1_only_lre},
-	{ID: "LGPL-2.1-or-later", LRE: license_LGPL_2_1_or_later_lre},
-	{ID: "LGPL-3.0", LRE: license_LGPL_3_0_lre},
-	{ID: "LGPL-3.0-only", LRE: license_LGPL_3_0_only_lre},
-	{ID: "LGPL-3.0-or-later", LRE: license_LGPL_3_0_or_later_lre},
-	{ID: "LGPLLR", LRE: license_LGPLLR_lre},
-	{ID: "LPL-1.0", LRE: license_LPL_1_0_lre},
-	{ID: "LPL-1.02", LRE: license_LPL_1_02_lre},
-	{ID: "LPPL-1.0", LRE: license_LPPL_1_0_lre},
-	{ID: "LPPL-1.1", LRE: license_LPPL_1_1_lre},
-	{ID: "LPPL-1.2", LRE: license_LPPL_1_2_lre},
-	{ID: "LPPL-1.3a", LRE: license_LPPL_1_3a_lre},
-	{ID: "LPPL-1.3c", LRE: license_LPPL_1_3c_lre},
-	{ID: "Latex2e", LRE: license_Latex2e_lre},
-	{ID: "Leptonica", LRE: license_Leptonica_lre},
-	{ID: "LiLiQ-P-1.1", LRE: license_LiLiQ_P_1_1_lre},
-	{ID: "LiLiQ-R-1.1", LRE: license_LiLiQ_R_1_1_lre},
-	{ID: "LiLiQ-Rplus-1.1", LRE: license_LiLiQ_Rplus_1_1_lre},
-	{ID: "Libpng", LRE: license_Libpng_lre},
-	{ID: "Linux-OpenIB", LRE: license_Linux_OpenIB_lre},
-	{ID: "MIT", LRE: license_MIT_lre},
-	{ID: "MIT-0", LRE: license_MIT_0_lre},
-	{ID: "MIT-CMU", LRE: license_MIT_CMU_lre},
+	{ID: "HaskellReport", Name: "Haskell Language Report License", LRE: license_HaskellReport_lre},
+	{ID: "Hippocratic-2.1", Name: "Hippocratic License 2.1", LRE: license_Hippocratic_2_1_lre},
+	{ID: "IBM-pibs", Name: "IBM PowerPC Initialization and Boot Software", LRE: license_IBM_pibs_lre},
+	{ID: "ICU", Name: "ICU License", LRE: license_ICU_lre},
+	{ID: "IJG", Name: "Independent JPEG Group License", LRE: license_IJG_lre},
+	{ID: "IPA", Name: "IPA Font License", OSIApproved: true, LRE: license_IPA_lre},
+	{ID: "IPL-1.0", Name: "IBM Public License v1.0", OSIApproved: true, LRE: license_IPL_1_0_lre},
+	{ID: "ISC", Name: "ISC License", OSIApproved: true, LRE: license_ISC_lre},
+	{ID: "ISC", IsGrant: true, LRE: license_ISC_Grant_lre},
+	{ID: "ImageMagick", Name: "ImageMagick License", LRE: license_ImageMagick_lre},
+	{ID: "Imlib2", Name: "Imlib2 License", LRE: license_Imlib2_lre},
+	{ID: "Info-ZIP", Name: "Info-ZIP License", LRE: license_Info_ZIP_lre},
+	{ID: "Intel", Name: "Intel Open Source License", LRE: license_Intel_lre},
+	{ID: "Intel-ACPI", Name: "Intel ACPI Software License Agreement", LRE: license_Intel_ACPI_lre},
+	{ID: "Interbase-1.0", Name: "Interbase Public License v1.0", LRE: license_Interbase_1_0_lre},
+	{ID: "JPNIC", Name: "Japan Network Information Center License", LRE: license_JPNIC_lre},
+	{ID: "JSON", Name: "JSON License", LRE: license_JSON_lre},
+	{ID: "Jam", Name: "Jam License", OSIApproved: true, LRE: license_Jam_lre},
+	{ID: "JasPer-2.0", Name: "JasPer License", LRE: license_JasPer_2_0_lre},
+	{ID: "LAL-1.2", Name: "Licence Art Libre 1.2", Lang: "fr", LRE: license_LAL_1_2_lre},
+	{ID: "LAL-1.3", Name: "Licence Art Libre 1.3", Lang: "fr", LRE: license_LAL_1_3_lre},
+	{ID: "LGPL", IsReference: true, LRE: license_LGPL_Family_Reference_lre},
+	{ID: "LGPL-2.0", Name: "GNU Library General Public License v2", OSIApproved: true, LRE: license_LGPL_2_0_lre},
+	{ID: "LGPL-2.0-only", Name: "GNU Library General Public License v2 only", OSIApproved: true, LRE: license_LGPL_2_0_only_lre},
+	{ID: "LGPL-2.0-or-later", Name: "GNU Library General Public License v2 or later", OSIApproved: true, LRE: license_LGPL_2_0_or_later_lre},
+	{ID: "LGPL-2.1", Name: "GNU Lesser General Public License v2.1", OSIApproved: true, LRE: license_LGPL_2_1_lre},
+	{ID: "LGPL-2.1", IsReference: true, LRE: license_LGPL_2_1_Reference_lre},
+	{ID: "LGPL-2.1-only", Name: "GNU Lesser General Public License v2.1 only", OSIApproved: true, LRE: license_LGPL_2_1_only_lre},
+	{ID: "LGPL-2.1-or-later", Name: "GNU Lesser General Public License v2.1 or later", OSIApproved: true, LRE: license_LGPL_2_1_or_later_lre},
+	{ID: "LGPL-3.0", Name: "GNU Lesser General Public License v3.0", OSIApproved: true, LRE: license_LGPL_3_0_lre},
+	{ID: "LGPL-3.0", IsReference: true, LRE: license_LGPL_3_0_Reference_lre},
+	{ID: "LGPL-3.0-only", Name: "GNU Lesser General Public License v3.0 only", OSIApproved: true, LRE: license_LGPL_3_0_only_lre},
+	{ID: "LGPL-3.0-or-later", Name: "GNU Lesser General Public License v3.0 or later", OSIApproved: true, LRE: license_LGPL_3_0_or_later_lre},
+	{ID: "LGPLLR", Name: "Lesser General Public License For Linguistic Resources", LRE: license_LGPLLR_lre},
+	{ID: "LPL-1.0", Name: "Lucent Public License Version 1.0", OSIApproved: true, LRE: license_LPL_1_0_lre},
+	{ID: "LPL-1.02", Name: "Lucent Public License v1.02", OSIApproved: true, LRE: license_LPL_1_02_lre},
+	{ID: "LPPL-1.0", Name: "LaTeX Project Public License v1.0", LRE: license_LPPL_1_0_lre},
+	{ID: "LPPL-1.1", Name: "LaTeX Project Public License v1.1", LRE: license_LPPL_1_1_lre},
+	{ID: "LPPL-1.2", Name: "LaTeX Project Public License v1.2", LRE: license_LPPL_1_2_lre},
+	{ID: "LPPL-1.3a", Name: "LaTeX Project Public License v1.3a", LRE: license_LPPL_1_3a_lre},
+	{ID: "LPPL-1.3c", Name: "LaTeX Project Public License v1.3c", OSIApproved: true, LRE: license_LPPL_1_3c_lre},
+	{ID: "Latex2e", Name: "Latex2e License", LRE: license_Latex2e_lre},
+	{ID: "Leptonica", Name: "Leptonica License", LRE: license_Leptonica_lre},
+	{ID: "LiLiQ-P-1.1", Name: "Licence Libre du Québec – Permissive version 1.1", OSIApproved: true, Lang: "fr", LRE: license_LiLiQ_P_1_1_lre},
+	{ID: "LiLiQ-R-1.1", Name: "Licence Libre du Québec – Réciprocité version 1.1", OSIApproved: true, Lang: "fr", LRE: license_LiLiQ_R_1_1_lre},
+	{ID: "LiLiQ-Rplus-1.1", Name: "Licence Libre du Québec – Réciprocité forte version 1.1", OSIApproved: true, Lang: "fr", LRE: license_LiLiQ_Rplus_1_1_lre},
+	{ID: "Libpng", Name: "libpng License", LRE: license_Libpng_lre},
+	{ID: "Linux-OpenIB", Name: "Linux Kernel Variant of OpenIB.org license", LRE: license_Linux_OpenIB_lre},
+	{ID: "MIT", Name: "MIT License", OSIApproved: true, LRE: license_MIT_lre},
+	{ID: "MIT", IsNotice: true, IsFragment: true, LRE: license_MIT_Fragment_lre},
+	{ID: "MIT", IsGrant: true, LRE: license_MIT_Grant_lre},
+	{ID: "MIT-0", Name: "MIT No Attribution", OSIApproved: true, LRE: license_MIT_0_lre},
+	{ID: "MIT-CMU", Name: "CMU License", LRE: license_MIT_CMU_lre},
 	{ID: "MIT-NoAd", LRE: license_MIT_NoAd_lre},
-	{ID: "MIT-advertising", LRE: license_MIT_advertising_lre},
-	{ID: "MIT-enna", LRE: license_MIT_enna_lre},
-	{ID: "MIT-feh", LRE: license_MIT_feh_lre},
-	{ID: "MITNFA", LRE: license_MITNFA_lre},
-	{ID: "MPL-1.0", LRE: license_MPL_1_0_lre},
-	{ID: "MPL-1.1", LRE: license_MPL_1_1_lre},
-	{ID: "MPL-2.0", LRE: license_MPL_2_0_lre},
-	{ID: "MPL-2.0-no-copyleft-exception", LRE: license_MPL_2_0_no_copyleft_exception_lre},
-	{ID: "MS-PL", LRE: license_MS_PL_lre},
-	{ID: "MS-RL", LRE: license_MS_RL_lre},
-	{ID: "MTLL", LRE: license_MTLL_lre},
-	{ID: "MakeIndex", LRE: license_MakeIndex_lre},
-	{ID: "MirOS", LRE: license_MirOS_lre},
-	{ID: "Motosoto", LRE: license_Motosoto_lre},
-	{ID: "MulanPSL-1.0", LRE: license_MulanPSL_1_0_lre},
-	{ID: "MulanPSL-2.0", LRE: license_MulanPSL_2_0_lre},
-	{ID: "Multics", LRE: license_Multics_lre},
-	{ID: "Mup", LRE: license_Mup_lre},
-	{ID: "NASA-1.3", LRE: license_NASA_1_3_lre},
-	{ID: "NBPL-1.0", LRE: license_NBPL_1_0_lre},
-	{ID: "NCGL-UK-2.0", LRE: license_NCGL_UK_2_0_lre},
-	{ID: "NCSA", LRE: license_NCSA_lre},
-	{ID: "NGPL", LRE: license_NGPL_lre},
-	{ID: "NIST-PD", LRE: license_NIST_PD_lre},
-	{ID: "NIST-PD-fallback", LRE: license_NIST_PD_fallback_lre},
-	{ID: "NLOD-1.0", LRE: license_NLOD_1_0_lre},
-	{ID: "NLPL", LRE: license_NLPL_lre},
-	{ID: "NOSL", LRE: license_NOSL_lre},
-	{ID: "NPL-1.0", LRE: license_NPL_1_0_lre},
-	{ID: "NPL-1.1", LRE: license_NPL_1_1_lre},
-	{ID: "NPOSL-3.0", LRE: license_NPOSL_3_0_lre},
-	{ID: "NRL", LRE: license_NRL_lre},
-	{ID: "NTP", LRE: license_NTP_lre},
-	{ID: "NTP-0", LRE: license_NTP_0_lre},
-	{ID: "Naumen", LRE: license_Naumen_lre},
-	{ID: "Net-SNMP", LRE: license_Net_SNMP_lre},
-	{ID: "NetCDF", LRE: license_NetCDF_lre},
-	{ID: "Newsletr", LRE: license_Newsletr_lre},
-	{ID: "Nokia", LRE: license_Nokia_lre},
-	{ID: "Noweb", LRE: license_Noweb_lre},
-	{ID: "O-UDA-1.0", LRE: license_O_UDA_1_0_lre},
-	{ID: "OCCT-PL", LRE: license_OCCT_PL_lre},
-	{ID: "OCLC-2.0", LRE: license_OCLC_2_0_lre},
-	{ID: "ODC-By-1.0", LRE: license_ODC_By_1_0_lre},
-	{ID: "ODbL-1.0", LRE: license_ODbL_1_0_lre},
-	{ID: "OFL-1.0", LRE: license_OFL_1_0_lre},
-	{ID: "OFL-1.1", LRE: license_OFL_1_1_lre},
-	{ID: "OGC-1.0", LRE: license_OGC_1_0_lre},
-	{ID: "OGL-Canada-2.0", LRE: license_OGL_Canada_2_0_lre},
-	{ID: "OGL-UK-1.0", LRE: license_OGL_UK_1_0_lre},
-	{ID: "OGL-UK-2.0", LRE: license_OGL_UK_2_0_lre},
-	{ID: "OGL-UK-3.0", LRE: license_OGL_UK_3_0_lre},
-	{ID: "OGTSL", LRE: license_OGTSL_lre},
-	{ID: "OLDAP-1.1", LRE: license_OLDAP_1_1_lre},
-	{ID: "OLDAP-1.2", LRE: license_OLDAP_1_2_lre},
-	{ID: "OLDAP-1.3", LRE: license_OLDAP_1_3_lre},
-	{ID: "OLDAP-1.4", LRE: license_OLDAP_1_4_lre},
-	{ID: "OLDAP-2.0", LRE: license_OLDAP_2_0_lre},
-	{ID: "OLDAP-2.0.1", LRE: license_OLDAP_2_0_1_lre},
-	{ID: "OLDAP-2.1", LRE: license_OLDAP_2_1_lre},
-	{ID: "OLDAP-2.2", LRE: license_OLDAP_2_2_lre},
-	{ID: "OLDAP-2.2.1", LRE: license_OLDAP_2_2_1_lre},
-	{ID: "OLDAP-2.2.2", LRE: license_OLDAP_2_2_2_lre},
-	{ID: "OLDAP-2.3", LRE: license_OLDAP_2_3_lre},
-	{ID: "OLDAP-2.4", LRE: license_OLDAP_2_4_lre},
-	{ID: "OLDAP-2.5", LRE: license_OLDAP_2_5_lre},
-	{ID: "OLDAP-2.6", LRE: license_OLDAP_2_6_lre},
-	{ID: "OLDAP-2.7", LRE: license_OLDAP_2_7_lre},
-	{ID: "OLDAP-2.8", LRE: license_OLDAP_2_8_lre},
-	{ID: "OML", LRE: license_OML_lre},
-	{ID: "OPL-1.0", LRE: license_OPL_1_0_lre},
-	{ID: "OSET-PL-2.1", LRE: license_OSET_PL_2_1_lre},
-	{ID: "OSL-1.0", LRE: license_OSL_1_0_lre},
-	{ID: "OSL-1.1", LRE: license_OSL_1_1_lre},
-	{ID: "OSL-2.0", LRE: license_OSL_2_0_lre},
-	{ID: "OSL-2.1", LRE: license_OSL_2_1_lre},
-	{ID: "OSL-3.0", LRE: license_OSL_3_0_lre},
-	{ID: "OpenSSL", LRE: license_OpenSSL_lre},
-	{ID: "PDDL-1.0", LRE: license_PDDL_1_0_lre},
-	{ID: "PHP-3.0", LRE: license_PHP_3_0_lre},
-	{ID: "PHP-3.01", LRE: license_PHP_3_01_lre},
-	{ID: "PSF-2.0", LRE: license_PSF_2_0_lre},
-	{ID: "Parity-6.0.0", LRE: license_Parity_6_0_0_lre},
-	{ID: "Parity-7.0.0", LRE: license_Parity_7_0_0_lre},
-	{ID: "Plexus", LRE: license_Plexus_lre},
-	{ID: "PolyForm-Noncommercial-1.0.0", LRE: license_PolyForm_Noncommercial_1_0_0_lre},
-	{ID: "PolyForm-Small-Business-1.0.0", LRE: license_PolyForm_Small_Business_1_0_0_lre},
-	{ID: "PostgreSQL", LRE: license_PostgreSQL_lre},
-	{ID: "Prosperity-3.0.0", LRE: license_Prosperity_3_0_0_lre},
-	{ID: "Python-2.0", LRE: license_Python_2_0_lre},
-	{ID: "QPL-1.0", LRE: license_QPL_1_0_lre},
-	{ID: "Qhull", LRE: license_Qhull_lre},
-	{ID: "RHeCos-1.1", LRE: license_RHeCos_1_1_lre},
-	{ID: "RPL-1.1", LRE: license_RPL_1_1_lre},
-	{ID: "RPL-1.5", LRE: license_RPL_1_5_lre},
-	{ID: "RPSL-1.0", LRE: license_RPSL_1_0_lre},
-	{ID: "RSA-MD", LRE: license_RSA_MD_lre},
-	{ID: "RSCPL", LRE: license_RSCPL_lre},
-	{ID: "Rdisc", LRE: license_Rdisc_lre},
-	{ID: "Ruby", LRE: license_Ruby_lre},
-	{ID: "SAX-PD", LRE: license_SAX_PD_lre},
-	{ID: "SCEA", LRE: license_SCEA_lre},
-	{ID: "SGI-B-1.0", LRE: license_SGI_B_1_0_lre},
-	{ID: "SGI-B-1.1", LRE: license_SGI_B_1_1_lre},
-	{ID: "SGI-B-2.0", LRE: license_SGI_B_2_0_lre},
-	{ID: "SHL-0.5", LRE: license_SHL_0_5_lre},
-	{ID: "SHL-0.51", LRE: license_SHL_0_51_lre},
-	{ID: "SISSL", LRE: license_SISSL_lre},
-	{ID: "SISSL-1.2", LRE: license_SISSL_1_2_lre},
-	{ID: "SMLNJ", LRE: license_SMLNJ_lre},
-	{ID: "SMPPL", LRE: license_SMPPL_lre},
-	{ID: "SNIA", LRE: license_SNIA_lre},
-	{ID: "SPL-1.0", LRE: license_SPL_1_0_lre},
-	{ID: "SSH-OpenSSH", LRE: license_SSH_OpenSSH_lre},
-	{ID: "SSH-short", LRE: license_SSH_short_lre},
-	{ID: "SSPL-1.0", LRE: license_SSPL_1_0_lre},
-	{ID: "SWL", LRE: license_SWL_lre},
-	{ID: "Saxpath", LRE: license_Saxpath_lre},
-	{ID: "Sendmail", LRE: license_Sendmail_lre},
-	{ID: "Sendmail-8.23", LRE: license_Sendmail_8_23_lre},
-	{ID: "SimPL-2.0", LRE: license_SimPL_2_0_lre},
-	{ID: "Sleepycat", LRE: license_Sleepycat_lre},
-	{ID: "Spencer-86", LRE: license_Spencer_86_lre},
-	{ID: "Spencer-94", LRE: license_Spencer_94_lre},
-	{ID: "Spencer-99", LRE: license_Spencer_99_lre},
-	{ID: "SugarCRM-1.1.3", LRE: license_SugarCRM_1_1_3_lre},
-	{ID: "TAPR-OHL-1.0", LRE: license_TAPR_OHL_1_0_lre},
-	{ID: "TCL", LRE: license_TCL_lre},
-	{ID: "TCP-wrappers", LRE: license_TCP_wrappers_lre},
-	{ID: "TMate", LRE: license_TMate_lre},
-	{ID: "TORQUE-1.1", LRE: license_TORQUE_1_1_lre},
-	{ID: "TOSL", LRE: license_TOSL_lre},
-	{ID: "TU-Berlin-1.0", LRE: license_TU_Berlin_1_0_lre},
-	{ID: "TU-Berlin-2.0", LRE: license_TU_Berlin_2_0_lre},
-	{ID: "UCL-1.0", LRE: license_UCL_1_0_lre},
-	{ID: "UPL-1.0", LRE: license_UPL_1_0_lre},
-	{ID: "Unicode-DFS-2015", LRE: license_Unicode_DFS_2015_lre},
-	{ID: "Unicode-DFS-2016", LRE: license_Unicode_DFS_2016_lre},
-	{ID: "Unicode-TOU", LRE: license_Unicode_TOU_lre},
-	{ID: "Unlicense", LRE: license_Unlicense_lre},
-	{ID: "VOSTROM", LRE: license_VOSTROM_lre},
-	{ID: "VSL-1.0", LRE: license_VSL_1_0_lre},
-	{ID: "Vim", LRE: license_Vim_lre},
-	{ID: "W3C", LRE: license_W3C_lre},
-	{ID: "W3C-19980720", LRE: license_W3C_19980720_lre},
-	{ID: "W3C-20150513", LRE: license_W3C_20150513_lre},
-	{ID: "WTFPL", Type: Discouraged, LRE: license_WTFPL_lre},
-	{ID: "Watcom-1.0", LRE: license_Watcom_1_0_lre},
-	{ID: "Wsuipa", LRE: license_Wsuipa_lre},
-	{ID: "X11", LRE: license_X11_lre},
-	{ID: "XFree86-1.1", LRE: license_XFree86_1_1_lre},
-	{ID: "XSkat", LRE: license_XSkat_lre},
-	{ID: "Xerox", LRE: license_Xerox_lre},
-	{ID: "Xnet", LRE: license_Xnet_lre},
-	{ID: "YPL-1.0", LRE: license_YPL_1_0_lre},
-	{ID: "YPL-1.1", LRE: license_YPL_1_1_lre},
-	{ID: "ZPL-1.1", LRE: license_ZPL_1_1_lre},
-	{ID: "ZPL-2.0", LRE: license_ZPL_2_0_lre},
-	{ID: "ZPL-2.1", LRE: license_ZPL_2_1_lre},
-	{ID: "Zed", LRE: license_Zed_lre},
-	{ID: "Zend-2.0", LRE: license_Zend_2_0_lre},
-	{ID: "Zimbra-1.3", LRE: license_Zimbra_1_3_lre},
-	{ID: "Zimbra-1.4", LRE: license_Zimbra_1_4_lre},
-	{ID: "Zlib", LRE: license_Zlib_lre},
-	{ID: "blessing", LRE: license_blessing_lre},
-	{ID: "bzip2-1.0.5", LRE: license_bzip2_1_0_5_lre},
-	{ID: "bzip2-1.0.6", LRE: license_bzip2_1_0_6_lre},
-	{ID: "copyleft-next-0.3.0", LRE: license_copyleft_next_0_3_0_lre},
-	{ID: "copyleft-next-0.3.1", LRE: license_copyleft_next_0_3_1_lre},
-	{ID: "curl", LRE: license_curl_lre},
-	{ID: "diffmark", LRE: license_diffmark_lre},
-	{ID: "dvipdfm", LRE: license_dvipdfm_lre},
-	{ID: "eGenix", LRE: license_eGenix_lre},
-	{ID: "etalab-2.0", LRE: license_etalab_2_0_lre},
-	{ID: "gSOAP-1.3b", LRE: license_gSOAP_1_3b_lre},
-	{ID: "gnuplot", LRE: license_gnuplot_lre},
-	{ID: "iMatix", LRE: license_iMatix_lre},
-	{ID: "libpng-2.0", LRE: license_libpng_2_0_lre},
-	{ID: "libselinux-1.0", LRE: license_libselinux_1_0_lre},
-	{ID: "libtiff", LRE: license_libtiff_lre},
-	{ID: "mpich2", LRE: license_mpich2_lre},
-	{ID: "psfrag", LRE: license_psfrag_lre},
-	{ID: "psutils", LRE: license_psutils_lre},
-	{ID: "xinetd", LRE: license_xinetd_lre},
-	{ID: "xpp", LRE: license_xpp_lre},
-	{ID: "zlib-acknowledgement", LRE: license_zlib_acknowledgement_lre},
+	{ID: "MIT-advertising", Name: "Enlightenment License (e16)", LRE: license_MIT_advertising_lre},
+	{ID: "MIT-enna", Name: "enna License", LRE: license_MIT_enna_lre},
+	{ID: "MIT-feh", Name: "feh License", LRE: license_MIT_feh_lre},
+	{ID: "MITNFA", Name: "MIT +no-false-attribs license", LRE: license_MITNFA_lre},
+	{ID: "MPL-1.0", Name: "Mozilla Public License 1.0", OSIApproved: true, LRE: license_MPL_1_0_lre},
+	{ID: "MPL-1.1", Name: "Mozilla Public License 1.1", OSIApproved: true, LRE: license_MPL_1_1_lre},
+	{ID: "MPL-2.0", Name: "Mozilla Public License 2.0", OSIApproved: true, LRE: license_MPL_2_0_lre},
+	{ID: "MPL-2.0", IsGrant: true, LRE: license_MPL_2_0_Grant_lre},
+	{ID: "MPL-2.0-no-copyleft-exception", OSIApproved: true, LRE: license_MPL_2_0_no_copyleft_exception_lre},
+	{ID: "MS-PL", Name: "Microsoft Public License", OSIApproved: true, LRE: license_MS_PL_lre},
+	{ID: "MS-PL", IsNotice: true, LRE: license_MS_PL_Notice_lre},
+	{ID: "MS-RL", Name: "Microsoft Reciprocal License", OSIApproved: true, LRE: license_MS_RL_lre},
+	{ID: "MS-RL", IsNotice: true, LRE: license_MS_RL_Notice_lre},
+	{ID: "MTLL", Name: "Matrix Template Library License", LRE: license_MTLL_lre},
+	{ID: "MakeIndex", Name: "MakeIndex License", LRE: license_MakeIndex_lre},
+	{ID: "MirOS", Name: "The MirOS Licence", OSIApproved: true, LRE: license_MirOS_lre},
+	{ID: "Motosoto", Name: "Motosoto License", OSIApproved: true, LRE: license_Motosoto_lre},
+	{ID: "MulanPSL-1.0", Name: "Mulan Permissive Software License, Version 1", LRE: license_MulanPSL_1_0_lre},
+	{ID: "MulanPSL-1.0", IsNotice: true, LRE: license_MulanPSL_1_0_Notice_lre},
+	{ID: "MulanPSL-2.0", Name: "Mulan Permissive Software License, Version 2", OSIApproved: true, LRE: license_MulanPSL_2_0_lre},
+	{ID: "MulanPSL-2.0", IsNotice: true, LRE: license_MulanPSL_2_0_Notice_lre},
+	{ID: "Multics", Name: "Multics License", OSIApproved: true, LRE: license_Multics_lre},
+	{ID: "Mup", Name: "Mup License", LRE: license_Mup_lre},
+	{ID: "NASA-1.3", Name: "NASA Open Source Agreement 1.3", OSIApproved: true, LRE: license_NASA_1_3_lre},
+	{ID: "NBPL-1.0", Name: "Net Boolean Public License v1", LRE: license_NBPL_1_0_lre},
+	{ID: "NCGL-UK-2.0", Name: "Non-Commercial Government Licence", LRE: license_NCGL_UK_2_0_lre},
+	{ID: "NCSA", Name: "University of Illinois/NCSA Open Source License", OSIApproved: true, LRE: license_NCSA_lre},
+	{ID: "NGPL", Name: "Nethack General Public License", OSIApproved: true, LRE: license_NGPL_lre},
+	{ID: "NIST-PD", Name: "NIST Public Domain Notice", LRE: license_NIST_PD_lre},
+	{ID: "NIST-PD-fallback", Name: "NIST Public Domain Notice with license fallback", LRE: license_NIST_PD_fallback_lre},
+	{ID: "NLOD-1.0", Name: "Norwegian Licence for Open Government Data", LRE: license_NLOD_1_0_lre},
+	{ID: "NLPL", Name: "No Limit Public License", LRE: license_NLPL_lre},
+	{ID: "NOSL", Name: "Netizen Open Source License", LRE: license_NOSL_lre},
+	{ID: "NPL-1.0", Name: "Netscape Public License v1.0", LRE: license_NPL_1_0_lre},
+	{ID: "NPL-1.1", Name: "Netscape Public License v1.1", LRE: license_NPL_1_1_lre},
+	{ID: "NPOSL-3.0", Name: "Non-Profit Open Software License 3.0", OSIApproved: true, LRE: license_NPOSL_3_0_lre},
+	{ID: "NRL", Name: "NRL License", LRE: license_NRL_lre},
+	{ID: "NTP", Name: "NTP License", OSIApproved: true, LRE: license_NTP_lre},
+	{ID: "NTP-0", Name: "NTP No Attribution", LRE: license_NTP_0_lre},
+	{ID: "Naumen", Name: "Naumen Public License", OSIApproved: true, LRE: license_Naumen_lre},
+	{ID: "Net-SNMP", Name: "Net-SNMP License", LRE: license_Net_SNMP_lre},
+	{ID: "NetCDF", Name: "NetCDF license", LRE: license_NetCDF_lre},
+	{ID: "Newsletr", Name: "Newsletr License", LRE: license_Newsletr_lre},
+	{ID: "NoLicense", Type: NoGrant, LRE: license_NoLicense_lre},
+	{ID: "Nokia", Name: "Nokia Open Source License", OSIApproved: true, LRE: license_Nokia_lre},
+	{ID: "Noweb", Name: "Noweb License", LRE: license_Noweb_lre},
+	{ID: "O-UDA-1.0", Name: "Open Use of Data Agreement v1.0", LRE: license_O_UDA_1_0_lre},
+	{ID: "OCCT-PL", Name: "Open CASCADE Technology Public License", LRE: license_OCCT_PL_lre},
+	{ID: "OCLC-2.0", Name: "OCLC Research Public License 2.0", OSIApproved: true, LRE: license_OCLC_2_0_lre},
+	{ID: "ODC-By-1.0", Name: "Open Data Commons Attribution License v1.0", LRE: license_ODC_By_1_0_lre},
+	{ID: "ODbL-1.0", Name: "ODC Open Database License v1.0", LRE: license_ODbL_1_0_lre},
+	{ID: "OFL-1.0", Name: "SIL Open Font License 1.0", LRE: license_OFL_1_0_lre},
+	{ID: "OFL-1.1", Name: "SIL Open Font License 1.1", OSIApproved: true, LRE: license_OFL_1_1_lre},
+	{ID: "OGC-1.0", Name: "OGC Software License, Version 1.0", LRE: license_OGC_1_0_lre},
+	{ID: "OGL-Canada-2.0", Name: "Open Government Licence - Canada", LRE: license_OGL_Canada_2_0_lre},
+	{ID: "OGL-UK-1.0", Name: "Open Government Licence v1.0", LRE: license_OGL_UK_1_0_lre},
+	{ID: "OGL-UK-2.0", Name: "Open Government Licence v2.0", LRE: license_OGL_UK_2_0_lre},
+	{ID: "OGL-UK-3.0", Name: "Open Government Licence v3.0", LRE: license_OGL_UK_3_0_lre},
+	{ID: "OGTSL", Name: "Open Group Test Suite License", OSIApproved: true, LRE: license_OGTSL_lre},
+	{ID: "OLDAP-1.1", Name: "Open LDAP Public License v1.1", LRE: license_OLDAP_1_1_lre},
+	{ID: "OLDAP-1.2", Name: "Open LDAP Public License v1.2", LRE: license_OLDAP_1_2_lre},
+	{ID: "OLDAP-1.3", Name: "Open LDAP Public License v1.3", LRE: license_OLDAP_1_3_lre},
+	{ID: "OLDAP-1.4", Name: "Open LDAP Public License v1.4", LRE: license_OLDAP_1_4_lre},
+	{ID: "OLDAP-2.0", Name: "Open LDAP Public License v2.0 (or possibly 2.0A and 2.0B)", LRE: license_OLDAP_2_0_lre},
+	{ID: "OLDAP-2.0.1", Name: "Open LDAP Public License v2.0.1", LRE: license_OLDAP_2_0_1_lre},
+	{ID: "OLDAP-2.1", Name: "Open LDAP Public License v2.1", LRE: license_OLDAP_2_1_lre},
+	{ID: "OLDAP-2.2", Name: "Open LDAP Public License v2.2", LRE: license_OLDAP_2_2_lre},
+	{ID: "OLDAP-2.2.1", Name: "Open LDAP Public License v2.2.1", LRE: license_OLDAP_2_2_1_lre},
+	{ID: "OLDAP-2.2.2", Name: "Open LDAP Public License 2.2.2", LRE: license_OLDAP_2_2_2_lre},
+	{ID: "OLDAP-2.3", Name: "Open LDAP Public License v2.3", LRE: license_OLDAP_2_3_lre},
+	{ID: "OLDAP-2.4", Name: "Open LDAP Public License v2.4", LRE: license_OLDAP_2_4_lre},
+	{ID: "OLDAP-2.5", Name: "Open LDAP Public License v2.5", LRE: license_OLDAP_2_5_lre},
+	{ID: "OLDAP-2.6", Name: "Open LDAP Public License v2.6", LRE: license_OLDAP_2_6_lre},
+	{ID: "OLDAP-2.7", Name: "Open LDAP Public License v2.7", LRE: license_OLDAP_2_7_lre},
+	{ID: "OLDAP-2.8", Name: "Open LDAP Public License v2.8", OSIApproved: true, LRE: license_OLDAP_2_8_lre},
+	{ID: "OML", Name: "Open Market License", LRE: license_OML_lre},
+	{ID: "OPL-1.0", Name: "Open Public License v1.0", LRE: license_OPL_1_0_lre},
+	{ID: "OSET-PL-2.1", Name: "OSET Public License version 2.1", OSIApproved: true, LRE: license_OSET_PL_2_1_lre},
+	{ID: "OSL-1.0", Name: "Open Software License 1.0", OSIApproved: true, LRE: license_OSL_1_0_lre},
+	{ID: "OSL-1.0", IsNotice: true, LRE: license_OSL_1_0_Notice_lre},
+	{ID: "OSL-1.1", Name: "Open Software License 1.1", LRE: license_OSL_1_1_lre},
+	{ID: "OSL-1.1", IsNotice: true, LRE: license_OSL_1_1_Notice_lre},
+	{ID: "OSL-2.0", Name: "Open Software License 2.0", OSIApproved: true, LRE: license_OSL_2_0_lre},
+	{ID: "OSL-2.0", IsNotice: true, LRE: license_OSL_2_0_Notice_lre},
+	{ID: "OSL-2.1", Name: "Open Software License 2.1", OSIApproved: true, LRE: license_OSL_2_1_lre},
+	{ID: "OSL-2.1", IsNotice: true, LRE: license_OSL_2_1_Notice_lre},
+	{ID: "OSL-3.0", Name: "Open Software License 3.0", OSIApproved: true, LRE: license_OSL_3_0_lre},
+	{ID: "OSL-3.0", IsNotice: true, LRE: license_OSL_3_0_Notice_lre},
+	{ID: "OpenSSL", Name: "OpenSSL License", LRE: license_OpenSSL_lre},
+	{ID: "PDDL-1.0", Name: "ODC Public Domain Dedication & License 1.0", LRE: license_PDDL_1_0_lre},
+	{ID: "PDM-1.0", IsNotice: true, LRE: license_PDM_1_0_Notice_lre},
+	{ID: "PHP-3.0", Name: "PHP License v3.0", OSIApproved: true, LRE: license_PHP_3_0_lre},
+	{ID: "PHP-3.01", Name: "PHP License v3.01", OSIApproved: true, LRE: license_PHP_3_01_lre},
+	{ID: "PSF-2.0", Name: "Python Software Foundation License 2.0", LRE: license_PSF_2_0_lre},
+	{ID: "Parity-6.0.0", Name: "The Parity Public License 6.0.0", LRE: license_Parity_6_0_0_lre},
+	{ID: "Parity-7.0.0", Name: "The Parity Public License 7.0.0", LRE: license_Parity_7_0_0_lre},
+	{ID: "Perl", Name: "Perl license: Artistic License 1.0 (Perl) OR GNU General Public License v1.0 or later", LRE: license_Perl_lre},
+	{ID: "Plexus", Name: "Plexus Classworlds License", LRE: license_Plexus_lre},
+	{ID: "PolyForm-Noncommercial-1.0.0", Name: "PolyForm Noncommercial License 1.0.0", LRE: license_PolyForm_Noncommercial_1_0_0_lre},
+	{ID: "PolyForm-Small-Business-1.0.0", Name: "PolyForm Small Business License 1.0.0", LRE: license_PolyForm_Small_Business_1_0_0_lre},
+	{ID: "PostgreSQL", Name: "PostgreSQL License", OSIApproved: true, LRE: license_PostgreSQL_lre},
+	{ID: "Prosperity-3.0.0", Name: "The Prosperity Public License 3.0.0", LRE: license_Prosperity_3_0_0_lre},
+	{ID: "Python-2.0", Name: "Python License 2.0", OSIApproved: true, LRE: license_Python_2_0_lre},
+	{ID: "QPL-1.0", Name: "Q Public License 1.0", OSIApproved: true, LRE: license_QPL_1_0_lre},
+	{ID: "Qhull", Name: "Qhull License", LRE: license_Qhull_lre},
+	{ID: "RHeCos-1.1", Name: "Red Hat eCos Public License v1.1", LRE: license_RHeCos_1_1_lre},
+	{ID: "RPL-1.1", Name: "Reciprocal Public License 1.1", OSIApproved: true, LRE: license_RPL_1_1_lre},
+	{ID: "RPL-1.5", Name: "Reciprocal Public License 1.5", OSIApproved: true, LRE: license_RPL_1_5_lre},
+	{ID: "RPSL-1.0", Name: "RealNetworks Public Source License v1.0", OSIApproved: true, LRE: license_RPSL_1_0_lre},
+	{ID: "RSA-MD", Name: "RSA Message-Digest License", LRE: license_RSA_MD_lre},
+	{ID: "RSCPL", Name: "Ricoh Source Code Public License", OSIApproved: true, LRE: license_RSCPL_lre},
+	{ID: "Rdisc", Name: "Rdisc License", LRE: license_Rdisc_lre},
+	{ID: "Ruby", Name: "Ruby License", LRE: license_Ruby_lre},
+	{ID: "SAX-PD", Name: "Sax Public Domain Notice", LRE: license_SAX_PD_lre},
+	{ID: "SCEA", Name: "SCEA Shared Source License", LRE: license_SCEA_lre},
+	{ID: "SGI-B-1.0", Name: "SGI Free Software License B v1.0", LRE: license_SGI_B_1_0_lre},
+	{ID: "SGI-B-1.1", Name: "SGI Free Software License B v1.1", LRE: license_SGI_B_1_1_lre},
+	{ID: "SGI-B-2.0", Name: "SGI Free Software License B v2.0", LRE: license_SGI_B_2_0_lre},
+	{ID: "SHL-0.5", Name: "Solderpad Hardware License v0.5", LRE: license_SHL_0_5_lre},
+	{ID: "SHL-0.51", Name: "Solderpad Hardware License, Version 0.51", LRE: license_SHL_0_51_lre},
+	{ID: "SISSL", Name: "Sun Industry Standards Source License v1.1", OSIApproved: true, LRE: license_SISSL_lre},
+	{ID: "SISSL-1.2", Name: "Sun Industry Standards Source License v1.2", LRE: license_SISSL_1_2_lre},
+	{ID: "SMLNJ", Name: "Standard ML of New Jersey License", LRE: license_SMLNJ_lre},
+	{ID: "SMPPL", Name: "Secure Messaging Protocol Public License", LRE: license_SMPPL_lre},
+	{ID: "SNIA", Name: "SNIA Public License 1.1", LRE: license_SNIA_lre},
+	{ID: "SPL-1.0", Name: "Sun Public License v1.0", OSIApproved: true, LRE: license_SPL_1_0_lre},
+	{ID: "SSH-OpenSSH", Name: "SSH OpenSSH license", LRE: license_SSH_OpenSSH_lre},
+	{ID: "SSH-short", Name: "SSH short notice", LRE: license_SSH_short_lre},
+	{ID: "SSLeay-standalone", Name: "SSLeay License - standalone", LRE: license_SSLeay_standalone_lre},
+	{ID: "SSPL-1.0", Name: "Server Side Public License, v 1", LRE: license_SSPL_1_0_lre},
+	{ID: "SWL", Name: "Scheme Widget Library (SWL) Software License Agreement", LRE: license_SWL_lre},
+	{ID: "Saxpath", Name: "Saxpath License", LRE: license_Saxpath_lre},
+	{ID: "Sendmail", Name: "Sendmail License", LRE: license_Sendmail_lre},
+	{ID: "Sendmail-8.23", Name: "Sendmail License 8.23", LRE: license_Sendmail_8_23_lre},
+	{ID: "SimPL-2.0", Name: "Simple Public License 2.0", OSIApproved: true, LRE: license_SimPL_2_0_lre},
+	{ID: "Sleepycat", Name: "Sleepycat License", OSIApproved: true, LRE: license_Sleepycat_lre},
+	{ID: "Spencer-86", Name: "Spencer License 86", LRE: license_Spencer_86_lre},
+	{ID: "Spencer-94", Name: "Spencer License 94", LRE: license_Spencer_94_lre},
+	{ID: "Spencer-99", Name: "Spencer License 99", LRE: license_Spencer_99_lre},
+	{ID: "SugarCRM-1.1.3", Name: "SugarCRM Public License v1.1.3", LRE: license_SugarCRM_1_1_3_lre},
+	{ID: "TAPR-OHL-1.0", Name: "TAPR Open Hardware License v1.0", LRE: license_TAPR_OHL_1_0_lre},
+	{ID: "TCL", Name: "TCL/TK License", LRE: license_TCL_lre},
+	{ID: "TCP-wrappers", Name: "TCP Wrappers License", LRE: license_TCP_wrappers_lre},
+	{ID: "TMate", Name: "TMate Open Source License", LRE: license_TMate_lre},
+	{ID: "TORQUE-1.1", Name: "TORQUE v2.5+ Software License v1.1", LRE: license_TORQUE_1_1_lre},
+	{ID: "TOSL", Name: "Trusster Open Source License", LRE: license_TOSL_lre},
+	{ID: "TU-Berlin-1.0", Name: "Technische Universitaet Berlin License 1.0", LRE: license_TU_Berlin_1_0_lre},
+	{ID: "TU-Berlin-2.0", Name: "Technische Universitaet Berlin License 2.0", LRE: license_TU_Berlin_2_0_lre},
+	{ID: "UCL-1.0", Name: "Upstream Compatibility License v1.0", OSIApproved: true, LRE: license_UCL_1_0_lre},
+	{ID: "UPL-1.0", Name: "Universal Permissive License v1.0", OSIApproved: true, LRE: license_UPL_1_0_lre},
+	{ID: "Unicode-DFS-2015", Name: "Unicode License Agreement - Data Files and Software (2015)", LRE: license_Unicode_DFS_2015_lre},
+	{ID: "Unicode-DFS-2016", Name: "Unicode License Agreement - Data Files and Software (2016)", OSIApproved: true, LRE: license_Unicode_DFS_2016_lre},
+	{ID: "Unicode-TOU", Name: "Unicode Terms of Use", LRE: license_Unicode_TOU_lre},
+	{ID: "Unicode-TOU", IsNotice: true, LRE: license_Unicode_TOU_Notice_lre},
+	{ID: "Unlicense", Name: "The Unlicense", OSIApproved: true, LRE: license_Unlicense_lre},
+	{ID: "VOSTROM", Name: "VOSTROM Public License for Open Source", LRE: license_VOSTROM_lre},
+	{ID: "VSL-1.0", Name: "Vovida Software License v1.0", OSIApproved: true, LRE: license_VSL_1_0_lre},
+	{ID: "Vim", Name: "Vim License", LRE: license_Vim_lre},
+	{ID: "Vim", IsGrant: true, LRE: license_Vim_Grant_lre},
+	{ID: "W3C", Name: "W3C Software Notice and License (2002-12-31)", OSIApproved: true, LRE: license_W3C_lre},
+	{ID: "W3C", IsNotice: true, LRE: license_W3C_Notice_lre},
+	{ID: "W3C-19980720", Name: "W3C Software Notice and License (1998-07-20)", LRE: license_W3C_19980720_lre},
+	{ID: "W3C-20150513", Name: "W3C Software Notice and Document License (2015-05-13)", LRE: license_W3C_20150513_lre},
+	{ID: "WTFPL", Name: "Do What The F*ck You Want To Public License", Type: Discouraged, LRE: license_WTFPL_lre},
+	{ID: "WTFPL", Type: Discouraged, IsNotice: true, LRE: license_WTFPL_Notice_lre},
+	{ID: "Watcom-1.0", Name: "Sybase Open Watcom Public License 1.0", OSIApproved: true, LRE: license_Watcom_1_0_lre},
+	{ID: "Wsuipa", Name: "Wsuipa License", LRE: license_Wsuipa_lre},
+	{ID: "X11", Name: "X11 License", LRE: license_X11_lre},
+	{ID: "XFree86-1.1", Name: "XFree86 License 1.1", LRE: license_XFree86_1_1_lre},
+	{ID: "XSkat", Name: "XSkat License", LRE: license_XSkat_lre},
+	{ID: "Xerox", Name: "Xerox License", LRE: license_Xerox_lre},
+	{ID: "Xnet", Name: "X.Net License", OSIApproved: true, LRE: license_Xnet_lre},
+	{ID: "YPL-1.0", Name: "Yahoo! Public License v1.0", LRE: license_YPL_1_0_lre},
+	{ID: "YPL-1.1", Name: "Yahoo! Public License v1.1", LRE: license_YPL_1_1_lre},
+	{ID: "ZPL-1.1", Name: "Zope Public License 1.1", LRE: license_ZPL_1_1_lre},
+	{ID: "ZPL-2.0", Name: "Zope Public License 2.0", OSIApproved: true, LRE: license_ZPL_2_0_lre},
+	{ID: "ZPL-2.0", IsNotice: true, LRE: license_ZPL_2_0_Notice_lre},
+	{ID: "ZPL-2.1", Name: "Zope Public License 2.1", OSIApproved: true, LRE: license_ZPL_2_1_lre},
+	{ID: "ZPL-2.1", IsNotice: true, LRE: license_ZPL_2_1_Notice_lre},
+	{ID: "Zed", Name: "Zed License", LRE: license_Zed_lre},
+	{ID: "Zend-2.0", Name: "Zend License v2.0", LRE: license_Zend_2_0_lre},
+	{ID: "Zimbra-1.3", Name: "Zimbra Public License v1.3", LRE: license_Zimbra_1_3_lre},
+	{ID: "Zimbra-1.4", Name: "Zimbra Public License v1.4", LRE: license_Zimbra_1_4_lre},
+	{ID: "Zlib", Name: "zlib License", OSIApproved: true, LRE: license_Zlib_lre},
+	{ID: "blessing", Name: "SQLite Blessing", LRE: license_blessing_lre},
+	{ID: "bzip2-1.0.5", Name: "bzip2 and libbzip2 License v1.0.5", LRE: license_bzip2_1_0_5_lre},
+	{ID: "bzip2-1.0.6", Name: "bzip2 and libbzip2 License v1.0.6", LRE: license_bzip2_1_0_6_lre},
+	{ID: "copyleft-next-0.3.0", Name: "copyleft-next 0.3.0", LRE: license_copyleft_next_0_3_0_lre},
+	{ID: "copyleft-next-0.3.1", Name: "copyleft-next 0.3.1", LRE: license_copyleft_next_0_3_1_lre},
+	{ID: "curl", Name: "curl License", LRE: license_curl_lre},
+	{ID: "curl", IsNotice: true, LRE: license_curl_Notice_lre},
+	{ID: "diffmark", Name: "diffmark license", LRE: license_diffmark_lre},
+	{ID: "dvipdfm", Name: "dvipdfm License", LRE: license_dvipdfm_lre},
+	{ID: "eGenix", Name: "eGenix.com Public License 1.1.0", LRE: license_eGenix_lre},
+	{ID: "etalab-2.0", Name: "Etalab Open License 2.0", Lang: "fr", LRE: license_etalab_2_0_lre},
+	{ID: "gSOAP-1.3b", Name: "gSOAP Public License v1.3b", LRE: license_gSOAP_1_3b_lre},
+	{ID: "gnuplot", Name: "gnuplot License", LRE: license_gnuplot_lre},
+	{ID: "iMatix", Name: "iMatix Standard Function Library Agreement", LRE: license_iMatix_lre},
+	{ID: "libpng-2.0", Name: "PNG Reference Library version 2", LRE: license_libpng_2_0_lre},
+	{ID: "libselinux-1.0", Name: "libselinux public domain notice", LRE: license_libselinux_1_0_lre},
+	{ID: "libtiff", Name: "libtiff License", LRE: license_libtiff_lre},
+	{ID: "mpich2", Name: "mpich2 License", LRE: license_mpich2_lre},
+	{ID: "psfrag", Name: "psfrag License", LRE: license_psfrag_lre},
+	{ID: "psutils", Name: "psutils License", LRE: license_psutils_lre},
+	{ID: "xinetd", Name: "xinetd License", LRE: license_xinetd_lre},
+	{ID: "xpp", Name: "XPP License", LRE: license_xpp_lre},
+	{ID: "zlib-acknowledgement", Name: "zlib/libpng License with Acknowledgement", LRE: license_zlib_acknowledgement_lre},
 }
 
 const license_0BSD_lre = `//**
@@ -434,6 +492,7 @@ https://spdx.org/licenses/0BSD.json
 http://landley.net/toybox/license.html
 **//
 
+
 //** Copyright **//
 
 Permission to use, copy, modify, and/or distribute this software for any purpose
@@ -453,6 +512,7 @@ https://spdx.org/licenses/AAL.json
 https://opensource.org/licenses/attribution
 **//
 
+
 (( Attribution Assurance License
 ((Copyright __20__))??
 ))??
@@ -527,6 +587,7 @@ http://opensource.linux-mirror.org/licenses/afl-1.1.txt
 http://wayback.archive.org/web/20021004124254/http://www.opensource.org/licenses/academic.php
 **//
 
+
 (( Academic Free License
 
 Version 1.1 ))??
@@ -610,6 +671,30 @@ This license is Copyright (C) 2002 Lawrence E. Rosen. All rights reserved.
 Permission is hereby granted to copy and distribute this license without
 modification. This license may not be modified without the express written
 permission of its copyright owner.
+`
+const license_AFL_1_1_Notice_lre = `
+//**
+Academic Free License 1.1, licensing notice
+such as "Licensed under the Academic Free License version 1.1"
+**//
+
+
+((
+	Licensed under the Academic Free License
+	((version || v.))??
+	1.1
+||
+	This source file is subject to the Academic Free License
+	(AFL 1.1)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_AFL_1_2_lre = `//**
 Academic Free License v1.2
@@ -618,6 +703,7 @@ http://opensource.linux-mirror.org/licenses/afl-1.2.txt
 http://wayback.archive.org/web/20021204204652/http://www.opensource.org/licenses/academic.php
 **//
 
+
 (( Academic Free License
 
 Version 1.2 ))??
@@ -701,6 +787,30 @@ This license is Copyright (C) 2002 Lawrence E. Rosen. All rights reserved.
 Permission is hereby granted to copy and distribute this license without
 modification. This license may not be modified without the express written
 permission of its copyright owner.
+`
+const license_AFL_1_2_Notice_lre = `
+//**
+Academic Free License 1.2, licensing notice
+such as "Licensed under the Academic Free License version 1.2"
+**//
+
+
+((
+	Licensed under the Academic Free License
+	((version || v.))??
+	1.2
+||
+	This source file is subject to the Academic Free License
+	(AFL 1.2)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_AFL_2_0_lre = `//**
 Academic Free License v2.0
@@ -708,6 +818,7 @@ https://spdx.org/licenses/AFL-2.0.json
 http://wayback.archive.org/web/20060924134533/http://www.opensource.org/licenses/afl-2.0.txt
 **//
 
+
 (( The Academic Free License
 
 v. 2.0 ))??
@@ -886,6 +997,30 @@ This license is Copyright (C) 2003 Lawrence E. Rosen. All rights reserved.
 Permission is hereby granted to copy and distribute this license without
 modification. This license may not be modified without the express written
 permission of its copyright owner.
+`
+const license_AFL_2_0_Notice_lre = `
+//**
+Academic Free License 2.0, licensing notice
+such as "Licensed under the Academic Free License version 2.0"
+**//
+
+
+((
+	Licensed under the Academic Free License
+	((version || v.))??
+	2.0
+||
+	This source file is subject to the Academic Free License
+	(AFL 2.0)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_AFL_2_1_lre = `//**
 Academic Free License v2.1
@@ -893,9 +1028,10 @@ https://spdx.org/licenses/AFL-2.1.json
 http://opensource.linux-mirror.org/licenses/afl-2.1.txt
 **//
 
+
 (( The Academic Free License
 
-v.2.1 ))??
+v. 2.1 ))??
 
 (( This Academic Free License (the "License") applies to any original work of
 authorship (the "Original Work") whose owner (the "Licensor") has placed the
@@ -1071,6 +1207,30 @@ This license is Copyright (C) 2003-2004 Lawrence E. Rosen. All rights reserved.
 Permission is hereby granted to copy and distribute this license without
 modification. This license may not be modified without the express written
 permission of its copyright owner.
+`
+const license_AFL_2_1_Notice_lre = `
+//**
+Academic Free License 2.1, licensing notice
+such as "Licensed under the Academic Free License version 2.1"
+**//
+
+
+((
+	Licensed under the Academic Free License
+	((version || v.))??
+	2.1
+||
+	This source file is subject to the Academic Free License
+	(AFL 2.1)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_AFL_3_0_lre = `//**
 Academic Free License v3.0
@@ -1079,6 +1239,7 @@ http://www.rosenlaw.com/AFL3.0.htm
 https://opensource.org/licenses/afl-3.0
 **//
 
+
 (( Academic Free License ("AFL") v. 3.0 ))??
 
 ((
@@ -1276,6 +1437,56 @@ Work:
    Initiative (OSI) and You comply with its license review and certification
    process.
 `
+const license_AFL_3_0_Notice_lre = `
+//**
+Academic Free License 3.0, licensing notice
+such as "Licensed under the Academic Free License version 3.0"
+**//
+
+
+((
+	Licensed under the Academic Free License
+	((version || v.))??
+	3.0
+||
+	This source file is subject to the Academic Free License
+	(AFL 3.0)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
+`
+const license_AGPL_Family_Reference_lre = `//**
+GNU Affero General Public License, reference by name without a version,
+such as "Licensed under the GNU AGPL" or "License: GNU Affero General Public License"
+**//
+
+
+
+
+((
+	((This file is || This program is || This library is || This software is || This code is || It is))??
+	((licensed || released || distributed || available || provided))
+	under
+	((the terms of))??
+	((the))??
+||
+	License:
+))
+
+((
+	((GNU))??
+	AGPL
+||
+	GNU Affero General Public License
+))
+((license))??
+`
 const license_AGPL_1_0_lre = `//**
 Affero General Public License v1.0
 http://www.affero.org/oagpl.html
@@ -1568,6 +1779,7 @@ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
 `
 const license_AGPL_1_0_only_lre = `
 //**
+Affero General Public License v1.0 only
 https://spdx.org/licenses/AGPL-1.0-only.json
 This header is an anachronism - AGPL 1.0 did not define a header.
 **//
@@ -1657,6 +1869,7 @@ This header is an anachronism - AGPL 1.0 did not define a header.
 `
 const license_AGPL_1_0_or_later_lre = `
 //**
+Affero General Public License v1.0 or later
 https://spdx.org/licenses/AGPL-1.0-only.json
 This header is an anachronism - AGPL 1.0 did not define a header.
 **//
@@ -1756,6 +1969,7 @@ https://www.gnu.org/licenses/agpl.txt
 https://opensource.org/licenses/AGPL-3.0
 **//
 
+
 ((
 	GNU AFFERO GENERAL PUBLIC LICENSE Version 3, 19 November 2007
 	
@@ -2292,6 +2506,9 @@ TERMS AND CONDITIONS
 
    __1__ Remote Network Interaction; Use with the GNU General Public License.
 
+   //** The network clause is what distinguishes the AGPL from the GPL,
+   whose section 13 is otherwise the same, so it is always required. **//
+
    Notwithstanding any other provision of this License, if you modify the
    Program, your modified version must prominently offer all users interacting
    with it remotely through a computer network (if your version supports such
@@ -2302,6 +2519,7 @@ TERMS AND CONDITIONS
    Corresponding Source for any work covered by version 3 of the GNU General
    Public License that is incorporated pursuant to the following paragraph.
 
+
    Notwithstanding any other provision of this License, you have permission to
    link or combine any covered work with a work licensed under version 3 of the
    GNU General Public License into a single combined work, and to convey the
@@ -2411,12 +2629,70 @@ programs; see section 13 for the specific requirements.
 You should also get your employer (if you work as a programmer) or school, if
 any, to sign a "copyright disclaimer" for the program, if necessary. For more
 information on this, and how to apply and follow the GNU AGPL, see <https:/www.gnu.org/licenses/>. ))??
+
+
+
+`
+const license_AGPL_3_0_Network_lre = `//**
+GNU Affero General Public License v3.0, section 13 network clause alone
+https://www.gnu.org/licenses/agpl-3.0.html#section13
+**//
+
+
+
+((13. Remote Network Interaction; Use with the GNU General Public License.))??
+
+
+   Notwithstanding any other provision of this License, if you modify the
+   Program, your modified version must prominently offer all users interacting
+   with it remotely through a computer network (if your version supports such
+   interaction) an opportunity to receive the Corresponding Source of your
+   version by providing access to the Corresponding Source from a network server
+   at no charge, through some standard or customary means of facilitating
+   copying of software. This Corresponding Source shall include the
+   Corresponding Source for any work covered by version 3 of the GNU General
+   Public License that is incorporated pursuant to the following paragraph.
+
+
+((
+	Notwithstanding any other provision of this License, you have permission to
+	link or combine any covered work with a work licensed under version 3 of the
+	GNU General Public License into a single combined work, and to convey the
+	resulting work. The terms of this License will continue to apply to the part
+	which is the covered work, but the work with which it is combined will remain
+	governed by version 3 of the GNU General Public License.
+))??
+`
+const license_AGPL_3_0_Reference_lre = `//**
+GNU Affero General Public License v3.0, compact reference by name
+such as "Licensed under AGPLv3" or "License: AGPL v3"
+**//
+
+
+
+
+((
+	((This file is || This program is || This library is || This software is || This code is || It is))??
+	((licensed || released || distributed || available || provided))
+	under
+	((the terms of))??
+	((the))??
+||
+	License:
+))
+
+((GNU))??
+AGPL
+((v3 || v3.0))
+((license))??
 `
 const license_AGPL_3_0_only_lre = `
 //**
+GNU Affero General Public License v3.0 only
 https://spdx.org/licenses/AGPL-3.0-only.json
 **//
 
+
 	
 	 
 	 
@@ -2502,9 +2778,11 @@ https://spdx.org/licenses/AGPL-3.0-only.json
 `
 const license_AGPL_3_0_or_later_lre = `
 //**
+GNU Affero General Public License v3.0 or later
 https://spdx.org/licenses/AGPL-3.0-only.json
 **//
 
+
 	
 	 
 	 
@@ -2821,6 +3099,7 @@ https://spdx.org/licenses/APL-1.0.json
 https://opensource.org/licenses/APL-1.0
 **//
 
+
 (( ADAPTIVE PUBLIC LICENSE
 
 Version 1.0 ))??
@@ -3826,6 +4105,7 @@ https://spdx.org/licenses/APSL-1.0.json
 https://fedoraproject.org/wiki/Licensing/Apple_Public_Source_License_1.0
 **//
 
+
 (( APPLE PUBLIC SOURCE LICENSE
 
 Version 1.0 - March 16, 1999 ))??
@@ -4232,6 +4512,7 @@ https://spdx.org/licenses/APSL-1.1.json
 http://www.opensource.apple.com/source/IOSerialFamily/IOSerialFamily-7/APPLE_LICENSE
 **//
 
+
 (( APPLE PUBLIC SOURCE LICENSE
 
 Version 1.1 - April 19, 1999 ))??
@@ -4641,6 +4922,7 @@ https://spdx.org/licenses/APSL-1.2.json
 http://www.samurajdata.se/opensource/mirror/licenses/apsl.php
 **//
 
+
 (( Apple Public Source License Ver. 1.2 ))??
 
    (( 1. ))??
@@ -5041,6 +5323,7 @@ https://spdx.org/licenses/APSL-2.0.json
 http://www.opensource.apple.com/license/apsl/
 **//
 
+
 (( APPLE PUBLIC SOURCE LICENSE
 
 Version 2.0 - August 6, 2003 ))??
@@ -6215,6 +6498,7 @@ http://apache.org/licenses/LICENSE-1.1
 https://opensource.org/licenses/Apache-1.1
 **//
 
+
 (( Apache License 1.1
 ((Copyright __20__))??
 ))??
@@ -6279,6 +6563,7 @@ http://www.apache.org/licenses/LICENSE-2.0
 https://opensource.org/licenses/Apache-2.0
 **//
 
+
 ((
 	((This program is))??
 	((Licensed || licenses this __1__))
@@ -6579,7 +6864,13 @@ recommend that a file or class name and description of purpose be included on
 the same "printed page" as the copyright notice for easier identification within
 third-party archives.
 
-	Copyright __20__
+	Copyright
+	((
+		//** As in the license, so that the placeholders are not reported as unfilled. **//
+		[yyyy] [name of copyright owner]
+	||
+		__20__
+	))
 
 	Licensed under the Apache License, Version 2.0 (the "License");
 	you may not use this file except in compliance with the License.
@@ -6595,6 +6886,46 @@ third-party archives.
 	limitations under the License.
 ))??
 
+))
+`
+const license_Apache_2_0_Grant_lre = `//**
+Apache License 2.0, granted in prose
+such as "This project is licensed under the Apache License 2.0."
+**//
+
+
+
+
+((
+	((This || The || Our || All))
+	((project || software || code || source code || library || program || package || module || repository || work))
+	((is || are))
+))??
+((
+	licensed under
+||
+	released under
+||
+	distributed under
+||
+	available under
+||
+	made available under
+||
+	provided under
+||
+	published under
+))
+((the terms of || the terms and conditions of))??
+((the))??
+
+((
+	Apache License
+	((Version 2.0 || v2.0 || 2.0))
+||
+	Apache
+	((2.0 || v2.0 || v2))
+	license
 ))
 `
 const license_Artistic_1_0_lre = `//**
@@ -6603,6 +6934,7 @@ https://spdx.org/licenses/Artistic-1.0.json
 https://opensource.org/licenses/Artistic-1.0
 **//
 
+
 (( The Artistic License ))??
 
 Preamble
@@ -6728,6 +7060,7 @@ https://spdx.org/licenses/Artistic-1.0-Perl.json
 http://dev.perl.org/licenses/artistic.html
 **//
 
+
 (( The "Artistic License" ))??
 
 Preamble
@@ -6872,6 +7205,7 @@ https://spdx.org/licenses/Artistic-1.0-cl8.json
 https://opensource.org/licenses/Artistic-1.0
 **//
 
+
 (( The Artistic License ))??
 
 Preamble
@@ -7004,6 +7338,7 @@ http://www.perlfoundation.org/artistic_license_2_0
 https://opensource.org/licenses/artistic-license-2.0
 **//
 
+
 (( The Artistic License 2.0 ))??
 
 ((Copyright (c) 2000-2006, The Perl Foundation.))??
@@ -7456,6 +7791,14 @@ https://spdx.org/licenses/BSD-2-Clause.json
 https://opensource.org/licenses/BSD-2-Clause
 **//
 
+
+((
+	BSD 2-Clause License
+	((Copyright __25__))??
+	((Copyright __25__))??
+))??
+
+
 	Redistribution and use
 	((of
 		((this software || __5__))
@@ -7585,6 +7928,48 @@ https://opensource.org/licenses/BSD-2-Clause
 	))
 
 
+`
+const license_BSD_2_Clause_Grant_lre = `//**
+BSD 2-Clause License, granted in prose
+such as "This library is distributed under the Simplified BSD License."
+**//
+
+
+
+
+((
+	((This || The || Our || All))
+	((project || software || code || source code || library || program || package || module || repository || work))
+	((is || are))
+))??
+((
+	licensed under
+||
+	released under
+||
+	distributed under
+||
+	available under
+||
+	made available under
+||
+	provided under
+||
+	published under
+))
+((the terms of || the terms and conditions of))??
+((the))??
+
+((
+	BSD 2-Clause
+||
+	2-Clause BSD
+||
+	Simplified BSD
+||
+	FreeBSD
+))
+license
 `
 const license_BSD_2_Clause_Patent_lre = `
 //**
@@ -7593,6 +7978,7 @@ https://spdx.org/licenses/BSD-2-Clause-Patent.json
 https://opensource.org/licenses/BSDplusPatent
 **//
 
+
 	Redistribution and use
 	((of
 		((this software || __5__))
@@ -7901,6 +8287,14 @@ https://spdx.org/licenses/BSD-3-Clause.json
 https://opensource.org/licenses/BSD-3-Clause
 **//
 
+
+((
+	BSD 3-Clause License
+	((Copyright __25__))??
+	((Copyright __25__))??
+))??
+
+
 	Redistribution and use
 	((of
 		((this software || __5__))
@@ -8044,6 +8438,50 @@ https://opensource.org/licenses/BSD-3-Clause
 	))
 
 
+`
+const license_BSD_3_Clause_Grant_lre = `//**
+BSD 3-Clause License, granted in prose
+such as "This software is released under the New BSD License."
+**//
+
+
+
+
+((
+	((This || The || Our || All))
+	((project || software || code || source code || library || program || package || module || repository || work))
+	((is || are))
+))??
+((
+	licensed under
+||
+	released under
+||
+	distributed under
+||
+	available under
+||
+	made available under
+||
+	provided under
+||
+	published under
+))
+((the terms of || the terms and conditions of))??
+((the))??
+
+((
+	BSD 3-Clause
+||
+	3-Clause BSD
+||
+	New BSD
+||
+	Modified BSD
+||
+	Revised BSD
+))
+license
 `
 const license_BSD_3_Clause_Attribution_lre = `
 //**
@@ -8364,12 +8802,21 @@ https://spdx.org/licenses/BSD-3-Clause-LBNL.json
 https://fedoraproject.org/wiki/Licensing/LBNLBSD
 **//
 
+
 //**
 BSD 3-Clause "New" or "Revised" License
 https://spdx.org/licenses/BSD-3-Clause.json
 https://opensource.org/licenses/BSD-3-Clause
 **//
 
+
+((
+	BSD 3-Clause License
+	((Copyright __25__))??
+	((Copyright __25__))??
+))??
+
+
 	Redistribution and use
 	((of
 		((this software || __5__))
@@ -8631,6 +9078,14 @@ https://spdx.org/licenses/BSD-3-Clause.json
 https://opensource.org/licenses/BSD-3-Clause
 **//
 
+
+((
+	BSD 3-Clause License
+	((Copyright __25__))??
+	((Copyright __25__))??
+))??
+
+
 	Redistribution and use
 	((of
 		((this software || __5__))
@@ -9186,7 +9641,7 @@ parties intellectual property rights.
 `
 const license_BSD_4_Clause_UC_lre = `
 //**
-BSD 4-Clause (Univeristy of California-Specific)
+BSD 4-Clause (University of California-Specific)
 https://spdx.org/licenses/BSD-4-Clause-UC.json
 http://www.freebsd.org/copyright/license.html
 **//
@@ -9709,6 +10164,7 @@ http://www.boost.org/LICENSE_1_0.txt
 https://opensource.org/licenses/BSL-1.0
 **//
 
+
 (( Boost Software License - Version 1.0 - August 17th, 2003 ))??
 
 Permission is hereby granted, free of charge, to any person or organization
@@ -9735,6 +10191,29 @@ COPYRIGHT HOLDERS OR ANYONE DISTRIBUTING THE SOFTWARE BE LIABLE FOR ANY DAMAGES
 OR OTHER LIABILITY, WHETHER IN CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF
 OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
 `
+const license_BSL_1_0_Notice_lre = `//**
+Boost Software License 1.0, short notice
+http://www.boost.org/LICENSE_1_0.txt
+**//
+
+
+
+((
+	Distributed under
+||
+	((Use, modification and distribution || Use, modification, and distribution || Distribution and use))
+	((is || are))
+	subject to
+))
+the Boost Software License, Version 1.0.
+((
+	See
+	((the))??
+	accompanying file
+	((LICENSE_1_0.txt || LICENSE.txt || LICENSE))
+	((or copy at http://www.boost.org/LICENSE_1_0.txt))??
+))??
+`
 const license_Bahyph_lre = `//**
 Bahyph License
 https://spdx.org/licenses/Bahyph.json
@@ -9807,6 +10286,40 @@ For more information about the BEER-WARE license please see phk's web site:
 http://people.freebsd.org/~phk/
 ))??
 `
+const license_Beerware_Grant_lre = `//**
+Beerware License, granted in prose
+such as "This project is licensed under the Beerware License."
+**//
+
+
+
+
+((
+	((This || The || Our || All))
+	((project || software || code || source code || library || program || package || module || repository || work))
+	((is || are))
+))??
+((
+	licensed under
+||
+	released under
+||
+	distributed under
+||
+	available under
+||
+	made available under
+||
+	provided under
+||
+	published under
+))
+((the terms of || the terms and conditions of))??
+((the))??
+
+((Beerware || Beer-Ware || Beer ware))
+((license || licence))
+`
 const license_BitTorrent_1_0_lre = `//**
 BitTorrent Open Source License v1.0
 https://spdx.org/licenses/BitTorrent-1.0.json
@@ -10924,6 +11437,7 @@ http://cryptographicautonomylicense.com/license-text.html
 https://opensource.org/licenses/CAL-1.0
 **//
 
+
 (( The Cryptographic Autonomy License, v. 1.0 ))??
 
 (( This ))??
@@ -11317,6 +11831,7 @@ https://spdx.org/licenses/CATOSL-1.1.json
 https://opensource.org/licenses/CATOSL-1.1
 **//
 
+
 (( Computer Associates Trusted Open Source License
 
 Version 1.1 ))??
@@ -12929,6 +13444,7 @@ https://spdx.org/licenses/CC-BY-3.0-AT.json
 https://creativecommons.org/licenses/by/3.0/at/legalcode
 **//
 
+
 CREATIVE COMMONS IST KEINE RECHTSANWALTSKANZLEI UND LEISTET KEINE
 RECHTSBERATUNG. DIE BEREITSTELLUNG DIESER LIZENZ FÜHRT ZU KEINEM
 MANDATSVERHÄLTNIS. CREATIVE COMMONS STELLT DIESE INFORMATIONEN OHNE GEWÄHR ZUR
@@ -22360,6 +22876,7 @@ https://spdx.org/licenses/CC-BY-SA-3.0-AT.json
 https://creativecommons.org/licenses/by-sa/3.0/at/legalcode
 **//
 
+
 CREATIVE COMMONS IST KEINE RECHTSANWALTSKANZLEI UND LEISTET KEINE
 RECHTSBERATUNG. DIE BEREITSTELLUNG DIESER LIZENZ FÜHRT ZU KEINEM
 MANDATSVERHÄLTNIS. CREATIVE COMMONS STELLT DIESE INFORMATIONEN OHNE GEWÄHR ZUR
@@ -23381,12 +23898,30 @@ effect of CC0 on those rights.
       party to this document and has no duty or obligation with respect to this
       CC0 or use of the Work.
 `
+const license_CC0_1_0_Notice_lre = `//**
+Creative Commons Zero v1.0 Universal, short waiver statement
+https://creativecommons.org/publicdomain/zero/1.0/
+**//
+
+
+
+((
+	CC0 1.0 Universal
+	((CC0 1.0))??
+))??
+
+To the extent possible under law,
+__20__
+has waived all copyright and related or neighboring rights to
+((this work))??
+`
 const license_CDDL_1_0_lre = `//**
 Common Development and Distribution License 1.0
 https://spdx.org/licenses/CDDL-1.0.json
 https://opensource.org/licenses/cddl1
 **//
 
+
 ((
 
 The accompanying software is licensed under the Common Development and
@@ -23786,6 +24321,33 @@ Version 1.0 ))??
 
 ))
 `
+const license_CDDL_1_0_Notice_lre = `//**
+Common Development and Distribution License 1.0, OpenSolaris file header
+https://github.com/illumos/illumos-gate/blob/master/usr/src/prototypes/prototype.c
+**//
+
+
+
+(( CDDL HEADER START ))??
+
+The contents of this file are subject to the terms of the
+Common Development and Distribution License
+(( , Version 1.0 only ))??
+(the "License").
+You may not use this file except in compliance with the License.
+
+You can obtain a copy of the license at __15__
+See the License for the specific language governing permissions
+and limitations under the License.
+((
+	When distributing Covered Code, include this CDDL HEADER in each
+	file and include the License file at __6__
+	If applicable, add the following below this CDDL HEADER, with the
+	fields enclosed by brackets "[]" replaced with your own identifying
+	information: Portions Copyright [yyyy] [name of copyright owner]
+))??
+(( CDDL HEADER END ))??
+`
 const license_CDDL_1_1_lre = `//**
 Common Development and Distribution License 1.1
 https://spdx.org/licenses/CDDL-1.1.json
@@ -24708,6 +25270,7 @@ https://spdx.org/licenses/CECILL-1.0.json
 http://www.cecill.info/licences/Licence_CeCILL_V1-fr.html
 **//
 
+
 (( CONTRAT DE LICENCE DE LOGICIEL LIBRE CeCILL ))??
 
 (( Avertissement
@@ -26311,6 +26874,7 @@ https://spdx.org/licenses/CECILL-2.1.json
 http://www.cecill.info/licences/Licence_CeCILL_V2.1-en.html
 **//
 
+
 (( CeCILL FREE SOFTWARE LICENSE AGREEMENT ))??
 
 Version 2.1 dated 2013-06-21
@@ -29323,6 +29887,7 @@ https://spdx.org/licenses/CNRI-Python.json
 https://opensource.org/licenses/CNRI-Python
 **//
 
+
 (( CNRI OPEN SOURCE LICENSE AGREEMENT ))??
 
 IMPORTANT: PLEASE READ THE FOLLOWING AGREEMENT CAREFULLY.
@@ -29485,6 +30050,7 @@ https://spdx.org/licenses/CPAL-1.0.json
 https://opensource.org/licenses/CPAL-1.0
 **//
 
+
 (( Common Public Attribution License Version 1.0 (CPAL) ))??
 
    (( 1. ))??
@@ -30100,6 +30666,7 @@ https://spdx.org/licenses/CPL-1.0.json
 https://opensource.org/licenses/CPL-1.0
 **//
 
+
 (( Common Public License Version 1.0 ))??
 
 THE ACCOMPANYING PROGRAM IS PROVIDED UNDER THE TERMS OF THIS COMMON PUBLIC
@@ -30624,6 +31191,7 @@ https://spdx.org/licenses/CUA-OPL-1.0.json
 https://opensource.org/licenses/CUA-OPL-1.0
 **//
 
+
 (( CUA Office Public License Version 1.0 ))??
 
    (( 1. ))??
@@ -31339,16 +31907,27 @@ received it.
 `
 const license_CommonsClause_lre = `//**
 CommonsClause addendum
+https://commonsclause.com/
 **//
 
+(( "Commons Clause" License Condition v1.0 ))??
+
 The Software is provided to you by the Licensor under the License, as defined
 below, subject to the following condition. Without limiting other conditions in
 the License, the grant of rights under the License will not include, and the
 License does not grant to you, the right to Sell the Software. For purposes of
 the foregoing, “Sell” means practicing any or all of the rights granted to you
 under the License to provide to third parties, for a fee or other consideration,
-a product or service that consists, entirely or substantially, of the Software
-or the functionality of the Software. Any license notice or attribution required
+((
+	a product or service that consists, entirely or substantially, of the Software
+	or the functionality of the Software.
+||
+	//** Revised wording, from the commonsclause.com text. **//
+	(including without limitation fees for hosting or consulting/ support services
+	related to the Software), a product or service whose value derives, entirely or
+	substantially, from the functionality of the Software.
+))
+Any license notice or attribution required
 by the License must also include this Commons Cause License Condition notice.
 `
 const license_Condor_1_1_lre = `//**
@@ -31586,6 +32165,7 @@ https://www.hbz-nrw.de/produkte/open-access/lizenzen/dfsl/D-FSL-1_0_de.txt/at_do
 https://www.hbz-nrw.de/produkte/open-access/lizenzen/dfsl/D-FSL-1_0_en.txt/at_download/file
 **//
 
+
 (( Deutsche Freie Software Lizenz ))??
 
 (c) Ministerium für Wissenschaft und Forschung Nordrhein-Westfalen 2004
@@ -32113,6 +32693,7 @@ https://spdx.org/licenses/ECL-1.0.json
 https://opensource.org/licenses/ECL-1.0
 **//
 
+
 (( The Educational Community License ))??
 
 This Educational Community License (the "License") applies to any original work
@@ -32149,12 +32730,36 @@ the changes were made.
 Any modifications of the Original Work must be distributed in such a manner as
 to avoid any confusion with the Original Work of the copyright holders.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
-COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
-IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
-CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 
 The name and trademarks of copyright holder(s) may NOT be used in advertising or
 publicity pertaining to the Original or Derivative Works without specific,
@@ -32167,6 +32772,7 @@ https://spdx.org/licenses/ECL-2.0.json
 https://opensource.org/licenses/ECL-2.0
 **//
 
+
 (( Educational Community License
 
 Version 2.0, April 2007 ))??
@@ -32421,6 +33027,7 @@ http://www.eiffel-nice.org/license/forum.txt
 https://opensource.org/licenses/EFL-1.0
 **//
 
+
 (( Eiffel Forum License, version 1 ))??
 
 Permission is hereby granted to use, copy, modify and/or distribute this
@@ -32454,6 +33061,7 @@ http://www.eiffel-nice.org/license/eiffel-forum-license-2.html
 https://opensource.org/licenses/EFL-2.0
 **//
 
+
 (( Eiffel Forum License, version 2 ))??
 
    (( 1. ))??
@@ -32577,6 +33185,7 @@ http://www.eclipse.org/legal/epl-v10.html
 https://opensource.org/licenses/EPL-1.0
 **//
 
+
 (( Eclipse Public License - v 1.0 ))??
 
 ((THE ACCOMPANYING PROGRAM))??
@@ -32823,6 +33432,22 @@ RECIPIENT'S ACCEPTANCE OF THIS AGREEMENT.
    after the cause of action arose. Each party waives its rights to a jury trial
    in any resulting litigation.
 `
+const license_EPL_1_0_Notice_lre = `//**
+Eclipse Public License 1.0, short notice
+https://www.eclipse.org/legal/copyrightandlicensenotice.php
+**//
+
+
+
+This program and the accompanying materials
+((are || is))
+made available under the terms of the Eclipse Public License
+((v1.0 || v 1.0 || v. 1.0 || 1.0 || version 1.0))
+((
+	which accompanies this distribution, and is available at
+	http://www.eclipse.org/legal/epl-v10.html
+))??
+`
 const license_EPL_2_0_lre = `//**
 Eclipse Public License 2.0
 https://spdx.org/licenses/EPL-2.0.json
@@ -32830,6 +33455,7 @@ https://www.eclipse.org/legal/epl-2.0
 https://www.opensource.org/licenses/EPL-2.0
 **//
 
+
 (( Eclipse Public License - v 2.0 ))??
 
 THE ACCOMPANYING PROGRAM IS PROVIDED UNDER THE TERMS OF THIS ECLIPSE PUBLIC
@@ -33111,6 +33737,8 @@ CONSTITUTES RECIPIENT'S ACCEPTANCE OF THIS AGREEMENT.
    enforceable by any entity that is not a Contributor or Recipient. No
    third-party beneficiary rights are created under this Agreement.
 
+   //** Some copies of the license omit Exhibit A. **//
+   ((
    Exhibit A - Form of Secondary Licenses Notice
 
    "This Source Code may also be made available under the following Secondary
@@ -33127,6 +33755,31 @@ CONSTITUTES RECIPIENT'S ACCEPTANCE OF THIS AGREEMENT.
    notice.
 
    You may add additional accurate notices of copyright ownership.
+   ))??
+`
+const license_EPL_2_0_Notice_lre = `//**
+Eclipse Public License 2.0, short notice
+https://www.eclipse.org/legal/epl-2.0/faq.php#h.tci84nlsqpgw
+**//
+
+
+
+This program and the accompanying materials
+((are || is))
+made available under the terms of the Eclipse Public License
+((2.0 || v2.0 || v 2.0 || v. 2.0 || version 2.0))
+((
+	which is available at
+	http://www.eclipse.org/legal/epl-2.0
+))??
+((
+	//** Exhibit A, with the secondary licenses left unmatched. **//
+	This Source Code may also be made available under the following Secondary
+	Licenses when the conditions for such availability set forth in the Eclipse
+	Public License,
+	((v. 2.0 || v2.0 || 2.0))
+	are satisfied:
+))??
 `
 const license_EUDatagrid_lre = `//**
 EU DataGrid Software License
@@ -33135,6 +33788,7 @@ http://eu-datagrid.web.cern.ch/eu-datagrid/license.html
 https://opensource.org/licenses/EUDatagrid
 **//
 
+
 (( EU DataGrid Software License
 ((Copyright __20__))??
 ))??
@@ -33549,6 +34203,7 @@ https://joinup.ec.europa.eu/sites/default/files/custom-page/attachment/eupl1.1.-
 https://opensource.org/licenses/EUPL-1.1
 **//
 
+
 (( European Union Public Licence V. 1.1
 (( EUPL ))??
 (( Copyright __20__ ))??
@@ -33865,24 +34520,53 @@ copyright notice for the Original Work:
       the Licensor, other than the European Commission, has no residence or
       registered office inside a European Union country.
 
-Appendix
+((
+	//** As in EUPL-1.2, the Appendix is optional. **//
+	Appendix
 
-"Compatible Licences" according to article 5 EUPL are:
+	"Compatible Licences" according to article 5 EUPL are:
 
-   -
-   GNU General Public License (GNU GPL) v. 2
+	   -
+	   GNU General Public License (GNU GPL) v. 2
 
-   -
-   Open Software License (OSL) v. 2.1, v. 3.0
+	   -
+	   Open Software License (OSL) v. 2.1, v. 3.0
 
-   -
-   Common Public License v. 1.0
+	   -
+	   Common Public License v. 1.0
 
-   -
-   Eclipse Public License v. 1.0
+	   -
+	   Eclipse Public License v. 1.0
+
+	   -
+	   Cecill v. 2.0
+))??
+`
+const license_EUPL_1_1_Notice_lre = `
+//**
+European Union Public License 1.1, short notice
+https://joinup.ec.europa.eu/collection/eupl/how-use-eupl
+**//
+
+
+	Licensed under the EUPL
+	(( , || V. || Version ))??
+	1.1
+	((
+		or as soon they will be approved by the European Commission
+		subsequent versions of the EUPL
+	))??
+	(( (the "Licence") ))??
+	((
+		You may not use this work except in compliance with the Licence.
+		You may obtain a copy of the Licence at: __12__
+		Unless required by applicable law or agreed to in writing, software
+		distributed under the Licence is distributed on an "AS IS" basis,
+		WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+		See the Licence for the specific language governing permissions and
+		limitations under the Licence.
+	))??
 
-   -
-   Cecill v. 2.0
 `
 const license_EUPL_1_2_lre = `//**
 European Union Public License 1.2
@@ -33892,8 +34576,12 @@ https://joinup.ec.europa.eu/sites/default/files/custom-page/attachment/eupl_v1.2
 https://joinup.ec.europa.eu/sites/default/files/inline-files/EUPL%20v1_2%20EN(1).txt
 http://eur-lex.europa.eu/legal-content/EN/TXT/HTML/?uri=CELEX:32017D0863
 https://opensource.org/licenses/EUPL-1.2
+
+The EUPL is equally valid in all official languages of the European Union;
+this matches only the English text.
 **//
 
+
 (( European Union Public Licence v. 1.2 ))??
 
 EUPL © the European Union 2007, 2016
@@ -34209,49 +34897,184 @@ copyright notice for the Work:
       this licence shall be governed by Belgian law if the Licensor has no seat,
       residence or registered office inside a European Union Member State.
 
-Appendix
+((
+	//**
+	The Appendix of compatible licences is often left out of copies,
+	and the European Commission may update it without a new version
+	of the EUPL, so it is optional.
+	**//
+	Appendix
 
-'Compatible Licences' according to Article 5 EUPL are:
+	'Compatible Licences' according to Article 5 EUPL are:
 
-   —
-   GNU General Public License (GPL) v. 2, v. 3
+	   —
+	   GNU General Public License (GPL) v. 2, v. 3
 
-   —
-   GNU Affero General Public License (AGPL) v. 3
+	   —
+	   GNU Affero General Public License (AGPL) v. 3
 
-   —
-   Open Software License (OSL) v. 2.1, v. 3.0
+	   —
+	   Open Software License (OSL) v. 2.1, v. 3.0
+
+	   —
+	   Eclipse Public License (EPL) v. 1.0
+
+	   —
+	   CeCILL v. 2.0, v. 2.1
+
+	   —
+	   Mozilla Public Licence (MPL) v. 2
 
-   —
-   Eclipse Public License (EPL) v. 1.0
+	   —
+	   GNU Lesser General Public Licence (LGPL) v. 2.1, v. 3
 
-   —
-   CeCILL v. 2.0, v. 2.1
+	   —
+	   Creative Commons Attribution-ShareAlike v. 3.0 Unported (CC BY-SA 3.0) for
+	   works other than software
 
-   —
-   Mozilla Public Licence (MPL) v. 2
+	   —
+	   European Union Public Licence (EUPL) v. 1.1, v. 1.2
 
-   —
-   GNU Lesser General Public Licence (LGPL) v. 2.1, v. 3
+	   —
+	   Québec Free and Open-Source Licence — Reciprocity (LiLiQ-R) or Strong
+	   Reciprocity (LiLiQ-R+).
 
-   —
-   Creative Commons Attribution-ShareAlike v. 3.0 Unported (CC BY-SA 3.0) for
-   works other than software
+	The European Commission may update this Appendix to later versions of the above
+	licences without producing a new version of the EUPL, as long as they provide
+	the rights granted in Article 2 of this Licence and protect the covered Source
+	Code from exclusive appropriation.
 
-   —
-   European Union Public Licence (EUPL) v. 1.1, v. 1.2
+	All other changes or additions to this Appendix require the production of a new
+	EUPL version.
+))??
+`
+const license_EUPL_1_2_Notice_lre = `
+//**
+European Union Public License 1.2, short notice
+https://joinup.ec.europa.eu/collection/eupl/how-use-eupl
+**//
 
-   —
-   Québec Free and Open-Source Licence — Reciprocity (LiLiQ-R) or Strong
-   Reciprocity (LiLiQ-R+).
 
-The European Commission may update this Appendix to later versions of the above
-licences without producing a new version of the EUPL, as long as they provide
-the rights granted in Article 2 of this Licence and protect the covered Source
-Code from exclusive appropriation.
+	Licensed under the EUPL
+	(( , || V. || Version ))??
+	1.2
+	((
+		or as soon they will be approved by the European Commission
+		subsequent versions of the EUPL
+	))??
+	(( (the "Licence") ))??
+	((
+		You may not use this work except in compliance with the Licence.
+		You may obtain a copy of the Licence at: __12__
+		Unless required by applicable law or agreed to in writing, software
+		distributed under the Licence is distributed on an "AS IS" basis,
+		WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+		See the Licence for the specific language governing permissions and
+		limitations under the Licence.
+	))??
+
+`
+const license_EUPL_1_2_de_lre = `
+//**
+European Union Public Licence 1.2, title of the German translation
+**//
+
+
+Open-Source-Lizenz für die Europäische Union
+
+((v. || v || version))
+1.2
+((
+	EUPL ©
+	__4__
+	2007, 2016
+))??
+
+`
+const license_EUPL_1_2_es_lre = `
+//**
+European Union Public Licence 1.2, title of the Spanish translation
+**//
+
+
+Licencia Pública de la Unión Europea
+
+((v. || v || version))
+1.2
+((
+	EUPL ©
+	__4__
+	2007, 2016
+))??
+
+`
+const license_EUPL_1_2_fr_lre = `
+//**
+European Union Public Licence 1.2, title of the French translation
+**//
+
+
+Licence Publique de l'Union Européenne
+
+((v. || v || version))
+1.2
+((
+	EUPL ©
+	__4__
+	2007, 2016
+))??
+
+`
+const license_EUPL_1_2_it_lre = `
+//**
+European Union Public Licence 1.2, title of the Italian translation
+**//
+
+
+Licenza Pubblica dell'Unione Europea
+
+((v. || v || version))
+1.2
+((
+	EUPL ©
+	__4__
+	2007, 2016
+))??
+
+`
+const license_EUPL_1_2_nl_lre = `
+//**
+European Union Public Licence 1.2, title of the Dutch translation
+**//
+
+
+Europese Unie Publieke Licentie
+
+((v. || v || version))
+1.2
+((
+	EUPL ©
+	__4__
+	2007, 2016
+))??
+
+`
+const license_EUPL_1_2_pt_lre = `
+//**
+European Union Public Licence 1.2, title of the Portuguese translation
+**//
+
+
+Licença Pública da União Europeia
+
+((v. || v || version))
+1.2
+((
+	EUPL ©
+	__4__
+	2007, 2016
+))??
 
-All other changes or additions to this Appendix require the production of a new
-EUPL version.
 `
 const license_Entessa_lre = `//**
 Entessa Public License v1.0
@@ -34259,6 +35082,7 @@ https://spdx.org/licenses/Entessa.json
 https://opensource.org/licenses/Entessa
 **//
 
+
 (( Entessa Public License Version. 1.0
 (( Copyright __20__ ))??
 ))??
@@ -34862,6 +35686,7 @@ http://fairlicense.org/
 https://opensource.org/licenses/Fair
 **//
 
+
 (( Fair License
 (( Copyright __20__ ))??
 ))??
@@ -34878,6 +35703,7 @@ https://spdx.org/licenses/Frameworx-1.0.json
 https://opensource.org/licenses/Frameworx-1.0
 **//
 
+
 (( THE FRAMEWORX OPEN LICENSE 1.0 ))??
 
 This License Agreement, The Frameworx Open License 1.0, has been entered into
@@ -37044,6 +37870,32 @@ SOFTWARE.
 
 Good luck and Godspeed.
 `
+const license_GPL_Family_Reference_lre = `//**
+GNU General Public License, reference by name without a version,
+such as "Licensed under the GNU GPL" or "License: GNU General Public License"
+**//
+
+
+
+
+((
+	((This file is || This program is || This library is || This software is || This code is || It is))??
+	((licensed || released || distributed || available || provided))
+	under
+	((the terms of))??
+	((the))??
+||
+	License:
+))
+
+((
+	((GNU))??
+	GPL
+||
+	GNU General Public License
+))
+((license))??
+`
 const license_GPL_1_0_lre = `//**
 GNU General Public License v1.0
 https://www.gnu.org/licenses/old-licenses/gpl-1.0-standalone.html
@@ -37336,6 +38188,7 @@ That's all there is to it! ))??
 `
 const license_GPL_1_0_only_lre = `
 //**
+GNU General Public License v1.0 only
 https://spdx.org/licenses/GPL-1.0-only.json
 **//
 
@@ -37424,6 +38277,7 @@ https://spdx.org/licenses/GPL-1.0-only.json
 `
 const license_GPL_1_0_or_later_lre = `
 //**
+GNU General Public License v1.0 or later
 https://spdx.org/licenses/GPL-1.0-or-later.json
 **//
 
@@ -37522,6 +38376,7 @@ https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html
 https://opensource.org/licenses/GPL-2.0
 **//
 
+
 ((
 	GNU GENERAL PUBLIC LICENSE Version 2, June 1991
 	
@@ -37550,6 +38405,7 @@ https://opensource.org/licenses/GPL-2.0
 
 ))??
 
+<<section "Preamble">>
 Preamble
 
 The licenses for most software are designed to take away your freedom to share
@@ -37601,6 +38457,7 @@ follow.
 
 __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
 
+<<section "0">>
    __1__ This License applies to any program or other work which contains a
    notice placed by the copyright holder saying it may be distributed under the
    terms of this General Public License. The "Program", below, refers to any
@@ -37618,6 +38475,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    made by running the Program). Whether that is true depends on what the
    Program does.
 
+<<section "1">>
    __1__ You may copy and distribute verbatim copies of the Program's source
    code as you receive it, in any medium, provided that you conspicuously and
    appropriately publish on each copy an appropriate copyright notice and
@@ -37628,6 +38486,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    You may charge a fee for the physical act of transferring a copy, and you may
    at your option offer warranty protection in exchange for a fee.
 
+<<section "2">>
    __1__ You may modify your copy or copies of the Program or any portion of it,
    thus forming a work based on the Program, and copy and distribute such
    modifications or work under the terms of Section 1 above, provided that you
@@ -37671,6 +38530,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    distribution medium does not bring the other work under the scope of this
    License.
 
+<<section "3">>
    __1__ You may copy and distribute the Program (or a work based on it, under
    Section 2) in object code or executable form under the terms of Sections 1
    and 2 above provided that you also do one of the following:
@@ -37707,6 +38567,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    even though third parties are not compelled to copy the source along with the
    object code.
 
+<<section "4">>
    __1__ You may not copy, modify, sublicense, or distribute the Program except
    as expressly provided under this License. Any attempt otherwise to copy,
    modify, sublicense or distribute the Program is void, and will automatically
@@ -37714,6 +38575,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    copies, or rights, from you under this License will not have their licenses
    terminated so long as such parties remain in full compliance.
 
+<<section "5">>
    __1__ You are not required to accept this License, since you have not signed
    it. However, nothing else grants you permission to modify or distribute the
    Program or its derivative works. These actions are prohibited by law if you
@@ -37722,6 +38584,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    this License to do so, and all its terms and conditions for copying,
    distributing or modifying the Program or works based on it.
 
+<<section "6">>
    __1__ Each time you redistribute the Program (or any work based on the
    Program), the recipient automatically receives a license from the original
    licensor to copy, distribute or modify the Program subject to these terms and
@@ -37729,6 +38592,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    exercise of the rights granted herein. You are not responsible for enforcing
    compliance by third parties to this License.
 
+<<section "7">>
    __1__ If, as a consequence of a court judgment or allegation of patent
    infringement or for any other reason (not limited to patent issues),
    conditions are imposed on you (whether by court order, agreement or
@@ -37759,6 +38623,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    This section is intended to make thoroughly clear what is believed to be a
    consequence of the rest of this License.
 
+<<section "8">>
    __1__ If the distribution and/or use of the Program is restricted in certain
    countries either by patents or by copyrighted interfaces, the original
    copyright holder who places the Program under this License may add an
@@ -37767,6 +38632,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    In such case, this License incorporates the limitation as if written in the
    body of this License.
 
+<<section "9">>
    __1__ The Free Software Foundation may publish revised and/or new versions of
    the General Public License from time to time. Such new versions will be
    similar in spirit to the present version, but may differ in detail to address
@@ -37779,6 +38645,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    Foundation. If the Program does not specify a version number of this License,
    you may choose any version ever published by the Free Software Foundation.
 
+<<section "10">>
    __1__ If you wish to incorporate parts of the Program into other free
    programs whose distribution conditions are different, write to the author to
    ask for permission. For software which is copyrighted by the Free Software
@@ -37789,6 +38656,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
 
    NO WARRANTY
 
+<<section "11">>
    __1__ BECAUSE THE PROGRAM IS LICENSED FREE OF CHARGE, THERE IS NO WARRANTY
    FOR THE PROGRAM, TO THE EXTENT PERMITTED BY APPLICABLE LAW. EXCEPT WHEN
    OTHERWISE STATED IN WRITING THE COPYRIGHT HOLDERS AND/OR OTHER PARTIES
@@ -37799,6 +38667,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    PROVE DEFECTIVE, YOU ASSUME THE COST OF ALL NECESSARY SERVICING, REPAIR OR
    CORRECTION.
 
+<<section "12">>
    __1__ IN NO EVENT UNLESS REQUIRED BY APPLICABLE LAW OR AGREED TO IN WRITING
    WILL ANY COPYRIGHT HOLDER, OR ANY OTHER PARTY WHO MAY MODIFY AND/OR
    REDISTRIBUTE THE PROGRAM AS PERMITTED ABOVE, BE LIABLE TO YOU FOR DAMAGES,
@@ -37812,6 +38681,7 @@ __5__ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
 ((END OF TERMS AND CONDITIONS))??
 
 ((
+
 How to Apply These Terms to Your New Programs
 
 If you develop a new program, and you want it to be of the greatest possible use
@@ -37877,21 +38747,143 @@ Yoyodyne, Inc., hereby disclaims all copyright interest in the program
 ` + "`" + `Gnomovision' (which makes passes at compilers) written by James Hacker.
 
 __5__ //**<signature of Ty Coon>**//, 1 April 1989 Ty Coon, President of Vice
+
 ))??
 
-((This General Public License does not permit incorporating your program
+((
+
+This General Public License does not permit incorporating your program
+into proprietary programs. If your program is a subroutine library, you may
+consider it more useful to permit linking proprietary applications with the
+library. If this is what you want to do, use the GNU
+((Lesser||Library))
+General Public
+License instead of this License.
+
+))??
+
+
+
+
+
+`
+const license_GPL_2_0_Reference_lre = `//**
+GNU General Public License v2.0, compact reference by name
+such as "Licensed under GPLv2" or "License: GPL v2"
+**//
+
+
+
+
+((
+	((This file is || This program is || This library is || This software is || This code is || It is))??
+	((licensed || released || distributed || available || provided))
+	under
+	((the terms of))??
+	((the))??
+||
+	License:
+))
+
+((GNU))??
+GPL
+((v2 || v2.0))
+((license))??
+
+
+`
+const license_GPL_2_0_HowToApply_lre = `//**
+GNU General Public License v2.0, How to Apply These Terms (instructions only)
+https://www.gnu.org/licenses/old-licenses/gpl-2.0.html#SEC4
+**//
+
+
+How to Apply These Terms to Your New Programs
+
+If you develop a new program, and you want it to be of the greatest possible use
+to the public, the best way to achieve this is to make it free software which
+everyone can redistribute and change under these terms.
+
+To do so, attach the following notices to the program. It is safest to attach
+them to the start of each source file to most effectively convey the exclusion
+of warranty; and each file should have at least the "copyright" line and a
+pointer to where the full notice is found.
+
+__30__
+//**
+<one line to give the program's name and a brief idea of what it does.>
+Copyright (C) <year> <name of author>
+**//
+
+This program is free software; you can redistribute it and/or modify it under
+the terms of the GNU General Public License as published by the Free Software
+Foundation; either version 2 of the License, or (at your option) any later
+version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE. See the GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License along with
+this program; if not, write to the Free Software Foundation, Inc.,
+
+	((
+		51 Franklin
+		((Street||St))
+		((Fifth Floor||Suite 500,))??
+		Boston, MA 02110 __1__ USA
+	||
+		59 Temple Place, Suite 330, Boston, MA  02111 __1__ USA
+	||
+		675 Mass Ave, Cambridge, MA 02139, USA
+	))
+
+Also add information on how to contact you by electronic and paper mail.
+
+If the program is interactive, make it output a short notice like this when it
+starts in an interactive mode:
+
+Gnomovision version 69, __10__
+
+Gnomovision comes with
+ABSOLUTELY NO WARRANTY; for details type ` + "`" + `show w'. This is free software, and
+you are welcome to redistribute it under certain conditions; type ` + "`" + `show c' for
+details.
+
+The hypothetical commands ` + "`" + `show w' and ` + "`" + `show c' should show the appropriate
+parts of the General Public License. Of course, the commands you use may be
+called something other than ` + "`" + `show w' and ` + "`" + `show c'; they could even be
+mouse-clicks or menu items--whatever suits your program.
+
+You should also get your employer (if you work as a programmer) or your school,
+if any, to sign a "copyright disclaimer" for the program, if necessary. Here is
+a sample; alter the names:
+
+Yoyodyne, Inc., hereby disclaims all copyright interest in the program
+` + "`" + `Gnomovision' (which makes passes at compilers) written by James Hacker.
+
+__5__ //**<signature of Ty Coon>**//, 1 April 1989 Ty Coon, President of Vice
+
+
+((
+
+This General Public License does not permit incorporating your program
 into proprietary programs. If your program is a subroutine library, you may
 consider it more useful to permit linking proprietary applications with the
 library. If this is what you want to do, use the GNU
 ((Lesser||Library))
 General Public
-License instead of this License.))??
+License instead of this License.
+
+))??
 `
 const license_GPL_2_0_only_lre = `
 //**
+GNU General Public License v2.0 only
 https://spdx.org/licenses/GPL-2.0-only.json
 **//
 
+
 	
 	 
 	 
@@ -37977,6 +38969,7 @@ https://spdx.org/licenses/GPL-2.0-only.json
 `
 const license_GPL_2_0_or_3_0_lre = `
 //** Used by MongoDB, WiredTiger, KeePassX, KeePassXC, maybe others **//
+
 This program is free software: you can redistribute it and/or modify
 it under the terms of the GNU General Public License as published by
 the Free Software Foundation, either version 2 or (at your option)
@@ -37989,9 +38982,11 @@ GNU General Public License for more details.
 `
 const license_GPL_2_0_or_later_lre = `
 //**
+GNU General Public License v2.0 or later
 https://spdx.org/licenses/GPL-2.0-or-later.json
 **//
 
+
 	
 	 
 	 
@@ -38087,6 +39082,7 @@ https://www.gnu.org/licenses/gpl-3.0-standalone.html
 https://opensource.org/licenses/GPL-3.0
 **//
 
+
 ((
 	GNU GENERAL PUBLIC LICENSE Version 3, 29 June 2007
 	
@@ -38115,6 +39111,7 @@ https://opensource.org/licenses/GPL-3.0
 
 ))??
 
+<<section "Preamble">>
 Preamble
 
 The GNU General Public License is a free, copyleft license for software and
@@ -38176,6 +39173,7 @@ follow.
 
 TERMS AND CONDITIONS
 
+<<section "0">>
    __1__ Definitions.
 
    "This License" refers to version 3 of the GNU General Public License.
@@ -38215,6 +39213,7 @@ TERMS AND CONDITIONS
    commands or options, such as a menu, a prominent item in the list meets this
    criterion.
 
+<<section "1">>
    __1__ Source Code.
 
    The "source code" for a work means the preferred form of the work for making
@@ -38252,6 +39251,7 @@ TERMS AND CONDITIONS
 
    The Corresponding Source for a work in source code form is that same work.
 
+<<section "2">>
    __1__ Basic Permissions.
 
    All rights granted under this License are granted for the term of copyright
@@ -38277,6 +39277,7 @@ TERMS AND CONDITIONS
    conditions stated below. Sublicensing is not allowed; section 10 makes it
    unnecessary.
 
+<<section "3">>
    __1__ Protecting Users' Legal Rights From Anti-Circumvention Law.
 
    No covered work shall be deemed part of an effective technological measure
@@ -38291,6 +39292,7 @@ TERMS AND CONDITIONS
    the work as a means of enforcing, against the work's users, your or third
    parties' legal rights to forbid circumvention of technological measures.
 
+<<section "4">>
    __1__ Conveying Verbatim Copies.
 
    You may convey verbatim copies of the Program's source code as you receive
@@ -38303,6 +39305,7 @@ TERMS AND CONDITIONS
    You may charge any price or no price for each copy that you convey, and you
    may offer support or warranty protection for a fee.
 
+<<section "5">>
    __1__ Conveying Modified Source Versions.
 
    You may convey a work based on the Program, or the modifications to produce
@@ -38338,6 +39341,7 @@ TERMS AND CONDITIONS
    of a covered work in an aggregate does not cause this License to apply to the
    other parts of the aggregate.
 
+<<section "6">>
    __1__ Conveying Non-Source Forms.
 
    You may convey a covered work in object code form under the terms of sections
@@ -38428,6 +39432,7 @@ TERMS AND CONDITIONS
    with an implementation available to the public in source code form), and must
    require no special password or key for unpacking, reading or copying.
 
+<<section "7">>
    __1__ Additional Terms.
 
    "Additional permissions" are terms that supplement the terms of this License
@@ -38491,6 +39496,7 @@ TERMS AND CONDITIONS
    a separately written license, or stated as exceptions; the above requirements
    apply either way.
 
+<<section "8">>
    __1__ Termination.
 
    You may not propagate or modify a covered work except as expressly provided
@@ -38515,6 +39521,7 @@ TERMS AND CONDITIONS
    your rights have been terminated and not permanently reinstated, you do not
    qualify to receive new licenses for the same material under section 10.
 
+<<section "9">>
    __1__ Acceptance Not Required for Having Copies.
 
    You are not required to accept this License in order to receive or run a copy
@@ -38526,6 +39533,7 @@ TERMS AND CONDITIONS
    or propagating a covered work, you indicate your acceptance of this License
    to do so.
 
+<<section "10">>
    __1__ Automatic Licensing of Downstream Recipients.
 
    Each time you convey a covered work, the recipient automatically receives a
@@ -38551,6 +39559,7 @@ TERMS AND CONDITIONS
    making, using, selling, offering for sale, or importing the Program or any
    portion of it.
 
+<<section "11">>
    __1__ Patents.
 
    A "contributor" is a copyright holder who authorizes use under this License
@@ -38616,6 +39625,7 @@ TERMS AND CONDITIONS
    implied license or other defenses to infringement that may otherwise be
    available to you under applicable patent law.
 
+<<section "12">>
    __1__ No Surrender of Others' Freedom.
 
    If conditions are imposed on you (whether by court order, agreement or
@@ -38628,6 +39638,7 @@ TERMS AND CONDITIONS
    only way you could satisfy both those terms and this License would be to
    refrain entirely from conveying the Program.
 
+<<section "13">>
    __1__ Use with the GNU Affero General Public License.
 
    Notwithstanding any other provision of this License, you have permission to
@@ -38638,6 +39649,7 @@ TERMS AND CONDITIONS
    Affero General Public License, section 13, concerning interaction through a
    network will apply to the combination as such.
 
+<<section "14">>
    __1__ Revised Versions of this License.
 
    The Free Software Foundation may publish revised and/or new versions of the
@@ -38662,6 +39674,7 @@ TERMS AND CONDITIONS
    However, no additional obligations are imposed on any author or copyright
    holder as a result of your choosing to follow a later version.
 
+<<section "15">>
    __1__ Disclaimer of Warranty.
 
    THERE IS NO WARRANTY FOR THE PROGRAM, TO THE EXTENT PERMITTED BY APPLICABLE
@@ -38673,6 +39686,7 @@ TERMS AND CONDITIONS
    SHOULD THE PROGRAM PROVE DEFECTIVE, YOU ASSUME THE COST OF ALL NECESSARY
    SERVICING, REPAIR OR CORRECTION.
 
+<<section "16">>
    __1__ Limitation of Liability.
 
    IN NO EVENT UNLESS REQUIRED BY APPLICABLE LAW OR AGREED TO IN WRITING WILL
@@ -38684,6 +39698,7 @@ TERMS AND CONDITIONS
    A FAILURE OF THE PROGRAM TO OPERATE WITH ANY OTHER PROGRAMS), EVEN IF SUCH
    HOLDER OR OTHER PARTY HAS BEEN ADVISED OF THE POSSIBILITY OF SUCH DAMAGES.
 
+<<section "17">>
    __1__ Interpretation of Sections 15 and 16.
 
    If the disclaimer of warranty and limitation of liability provided above
@@ -38696,6 +39711,7 @@ TERMS AND CONDITIONS
 (( END OF TERMS AND CONDITIONS))??
 
 ((
+
 How to Apply These Terms to Your New Programs
 
 If you develop a new program, and you want it to be of the greatest possible use
@@ -38751,13 +39767,107 @@ The GNU General Public License does not permit incorporating your program into
 proprietary programs. If your program is a subroutine library, you may consider
 it more useful to permit linking proprietary applications with the library. If
 this is what you want to do, use the GNU Lesser General Public License instead
-of this License. But first, please read <https://www.gnu.org/licenses/why-not-lgpl.html>.))??
+of this License. But first, please read <https://www.gnu.org/licenses/why-not-lgpl.html>.
+
+))??
+
+
+
+`
+const license_GPL_3_0_Reference_lre = `//**
+GNU General Public License v3.0, compact reference by name
+such as "Licensed under GPLv3" or "License: GPL v3"
+**//
+
+
+
+
+((
+	((This file is || This program is || This library is || This software is || This code is || It is))??
+	((licensed || released || distributed || available || provided))
+	under
+	((the terms of))??
+	((the))??
+||
+	License:
+))
+
+((GNU))??
+GPL
+((v3 || v3.0))
+((license))??
+`
+const license_GPL_3_0_HowToApply_lre = `//**
+GNU General Public License v3.0, How to Apply These Terms (instructions only)
+https://www.gnu.org/licenses/gpl-3.0.html#howto
+**//
+
+
+How to Apply These Terms to Your New Programs
+
+If you develop a new program, and you want it to be of the greatest possible use
+to the public, the best way to achieve this is to make it free software which
+everyone can redistribute and change under these terms.
+
+
+
+To do so, attach the following notices to the program. It is safest to attach
+them to the start of each source file to most effectively state the exclusion of
+warranty; and each file should have at least the "copyright" line and a pointer
+to where the full notice is found.
+
+__30__
+//**
+<one line to give the program's name and a brief idea of what it does.>
+Copyright (C) <year> <name of author>
+**//
+
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU General Public License as published by the Free Software
+Foundation, either version 3 of the License, or (at your option) any later
+version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE. See the GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License along with
+this program. If not, see <https:/www.gnu.org/licenses/>.
+
+Also add information on how to contact you by electronic and paper mail.
+
+If the program does terminal interaction, make it output a short notice like
+this when it starts in an interactive mode:
+
+<program> __10__
+
+This program comes with ABSOLUTELY NO WARRANTY; for details type ` + "`" + `show w'.
+
+This is free software, and you are welcome to redistribute it under certain
+conditions; type ` + "`" + `show c' for details.
+
+The hypothetical commands ` + "`" + `show w' and ` + "`" + `show c' should show the appropriate
+parts of the General Public License. Of course, your program's commands might be
+different; for a GUI interface, you would use an "about box".
+
+You should also get your employer (if you work as a programmer) or school, if
+any, to sign a "copyright disclaimer" for the program, if necessary. For more
+information on this, and how to apply and follow the GNU GPL, see <https:/www.gnu.org/licenses/>.
+
+The GNU General Public License does not permit incorporating your program into
+proprietary programs. If your program is a subroutine library, you may consider
+it more useful to permit linking proprietary applications with the library. If
+this is what you want to do, use the GNU Lesser General Public License instead
+of this License. But first, please read <https://www.gnu.org/licenses/why-not-lgpl.html>.
+
 `
 const license_GPL_3_0_only_lre = `
 //**
+GNU General Public License v3.0 only
 https://spdx.org/licenses/GPL-3.0-only.json
 **//
 
+
 	
 	 
 	 
@@ -38843,9 +39953,11 @@ https://spdx.org/licenses/GPL-3.0-only.json
 `
 const license_GPL_3_0_or_later_lre = `
 //**
+GNU General Public License v3.0 or later
 https://spdx.org/licenses/GPL-3.0-or-later.json
 **//
 
+
 	
 	 
 	 
@@ -39320,6 +40432,7 @@ shall terminate as of the date such litigation is filed.
 const license_HPND_lre = `
 
 
+
 	//**
 	Historical Permission Notice and Disclaimer
 	https://spdx.org/licenses/HPND.json
@@ -39749,7 +40862,8 @@ https://spdx.org/licenses/ICU.json
 http://source.icu-project.org/repos/icu/icu/trunk/license.html
 **//
 
-(( ICU License - ICU 1.8.1 and later
+(( ICU License - ICU 1.8.1
+(( and later || to ICU 57.1 ))
 COPYRIGHT AND PERMISSION NOTICE
 ((Copyright __20__))??
 ))??
@@ -39775,6 +40889,11 @@ WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
 Except as contained in this notice, the name of a copyright holder shall not be
 used in advertising or otherwise to promote the sale, use or other dealings in
 this Software without prior written authorization of the copyright holder.
+((
+	//** Trailer of ICU 57 and earlier's license file. **//
+	All trademarks and registered trademarks mentioned herein are the
+	property of their respective owners.
+))??
 `
 const license_IJG_lre = `//**
 Independent JPEG Group License
@@ -39884,6 +41003,7 @@ https://spdx.org/licenses/IPA.json
 https://opensource.org/licenses/IPA
 **//
 
+
 (( IPA Font License Agreement v1.0 ))??
 
 The Licensor provides the Licensed Program (as defined in Article 1 below) under
@@ -40106,6 +41226,7 @@ https://spdx.org/licenses/IPL-1.0.json
 https://opensource.org/licenses/IPL-1.0
 **//
 
+
 (( IBM Public License Version 1.0 ))??
 
 THE ACCOMPANYING PROGRAM IS PROVIDED UNDER THE TERMS OF THIS IBM
@@ -40380,6 +41501,7 @@ https://www.isc.org/downloads/software-support-policy/isc-license/
 https://opensource.org/licenses/ISC
 **//
 
+
 ((
 ISC License
 ((Copyright __20__))??
@@ -40405,6 +41527,39 @@ DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
 ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN
 CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
 `
+const license_ISC_Grant_lre = `//**
+ISC License, granted in prose
+such as "This package is released under the ISC license."
+**//
+
+
+
+
+((
+	((This || The || Our || All))
+	((project || software || code || source code || library || program || package || module || repository || work))
+	((is || are))
+))??
+((
+	licensed under
+||
+	released under
+||
+	distributed under
+||
+	available under
+||
+	made available under
+||
+	provided under
+||
+	published under
+))
+((the terms of || the terms and conditions of))??
+((the))??
+
+ISC license
+`
 const license_ImageMagick_lre = `//**
 ImageMagick License
 https://spdx.org/licenses/ImageMagick.json
@@ -40708,12 +41863,36 @@ software provided.
 Please see the COPYING-PLAIN for a plain-english explanation of this notice and
 its intent.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS BE
-LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF
-CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
-SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 `
 const license_Info_ZIP_lre = `//**
 Info-ZIP License
@@ -41603,12 +42782,49 @@ The Software
 ((shall || should rather))
 be used for Good, not Evil.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
-COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
-IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
-CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
+`
+const license_Jam_lre = `//**
+Jam License
+https://spdx.org/licenses/Jam.json
+https://www.boost.org/doc/libs/1_35_0/doc/html/jam.html
+https://web.archive.org/web/20160330173339/https://swarm.workshop.perforce.com/files/guest/perforce_software/jam/src/README
+**//
+
+
+License is hereby granted to use this software and distribute it freely, as
+long as this copyright notice is retained and modifications are clearly marked.
+
+ALL WARRANTIES ARE HEREBY DISCLAIMED.
 `
 const license_JasPer_2_0_lre = `//**
 JasPer License
@@ -41668,6 +42884,7 @@ https://spdx.org/licenses/LAL-1.2.json
 http://artlibre.org/licence/lal/licence-art-libre-12/
 **//
 
+
 (( Licence Art Libre
 
 [ Copyleft Attitude ]
@@ -41861,6 +43078,7 @@ https://spdx.org/licenses/LAL-1.3.json
 https://artlibre.org/
 **//
 
+
 (( Licence Art Libre 1.3 (LAL 1.3) ))??
 
 Préambule :
@@ -42078,11 +43296,40 @@ Copie : Toute reproduction d'un original au sens de cette licence.
    Cette licence est rédigée en référence au droit français et à la
    Convention de Berne relative au droit d'auteur.
 `
+const license_LGPL_Family_Reference_lre = `//**
+GNU Lesser General Public License, reference by name without a version,
+such as "Licensed under the GNU LGPL" or "License: GNU Lesser General Public License"
+**//
+
+
+
+
+((
+	((This file is || This program is || This library is || This software is || This code is || It is))??
+	((licensed || released || distributed || available || provided))
+	under
+	((the terms of))??
+	((the))??
+||
+	License:
+))
+
+((
+	((GNU))??
+	LGPL
+||
+	GNU
+	((Lesser || Library))
+	General Public License
+))
+((license))??
+`
 const license_LGPL_2_0_lre = `//**
 GNU Library General Public License v2
 https://www.gnu.org/licenses/old-licenses/lgpl-2.0-standalone.html
 **//
 
+
 ((
 	GNU LIBRARY GENERAL PUBLIC LICENSE Version 2, June 1991
 	
@@ -42578,9 +43825,11 @@ That's all there is to it! ))??
 `
 const license_LGPL_2_0_only_lre = `
 //**
+GNU Library General Public License v2 only
 https://spdx.org/licenses/LGPL-2.0-only.json
 **//
 
+
 	
 	 
 	 
@@ -42682,9 +43931,11 @@ GNU))
 `
 const license_LGPL_2_0_or_later_lre = `
 //**
+GNU Library General Public License v2 or later
 https://spdx.org/licenses/LGPL-2.0-or-later.json
 **//
 
+
 	
 	 
 	 
@@ -42796,6 +44047,7 @@ https://www.gnu.org/licenses/old-licenses/lgpl-2.1-standalone.html
 https://opensource.org/licenses/LGPL-2.1
 **//
 
+
 ((
 	GNU LESSER GENERAL PUBLIC LICENSE Version 2.1, February 1999
 	
@@ -43314,11 +44566,35 @@ Ty Coon, President of Vice
 
 That's all there is to it! ))??
 `
+const license_LGPL_2_1_Reference_lre = `//**
+GNU Lesser General Public License v2.1, compact reference by name
+such as "Licensed under LGPLv2.1" or "License: LGPL v2.1"
+**//
+
+
+
+
+((
+	((This file is || This program is || This library is || This software is || This code is || It is))??
+	((licensed || released || distributed || available || provided))
+	under
+	((the terms of))??
+	((the))??
+||
+	License:
+))
+
+((GNU))??
+LGPL v2.1
+((license))??
+`
 const license_LGPL_2_1_only_lre = `
 //**
+GNU Lesser General Public License v2.1 only
 https://spdx.org/licenses/LGPL-2.1-only.json
 **//
 
+
 	
 	 
 	 
@@ -43420,9 +44696,11 @@ GNU))
 `
 const license_LGPL_2_1_or_later_lre = `
 //**
+GNU Lesser General Public License v2.1 or later
 https://spdx.org/licenses/LGPL-2.1-or-later.json
 **//
 
+
 	
 	 
 	 
@@ -43534,6 +44812,7 @@ https://www.gnu.org/licenses/lgpl-3.0-standalone.html
 https://opensource.org/licenses/LGPL-3.0
 **//
 
+
 ((
 	GNU LESSER GENERAL PUBLIC LICENSE Version 3, 29 June 2007
 	
@@ -43724,11 +45003,36 @@ additional permissions listed below.
    proxy's public statement of acceptance of any version is permanent
    authorization for you to choose that version for the Library.
 `
+const license_LGPL_3_0_Reference_lre = `//**
+GNU Lesser General Public License v3.0, compact reference by name
+such as "Licensed under LGPLv3" or "License: LGPL v3"
+**//
+
+
+
+
+((
+	((This file is || This program is || This library is || This software is || This code is || It is))??
+	((licensed || released || distributed || available || provided))
+	under
+	((the terms of))??
+	((the))??
+||
+	License:
+))
+
+((GNU))??
+LGPL
+((v3 || v3.0))
+((license))??
+`
 const license_LGPL_3_0_only_lre = `
 //**
+GNU Lesser General Public License v3.0 only
 https://spdx.org/licenses/LGPL-3.0-only.json
 **//
 
+
 	
 	 
 	 
@@ -43830,9 +45134,11 @@ GNU))
 `
 const license_LGPL_3_0_or_later_lre = `
 //**
+GNU Lesser General Public License v3.0 or later
 https://spdx.org/licenses/LGPL-3.0-or-later.json
 **//
 
+
 	
 	 
 	 
@@ -44232,6 +45538,7 @@ https://spdx.org/licenses/LPL-1.0.json
 https://opensource.org/licenses/LPL-1.0
 **//
 
+
 (( Lucent Public License Version 1.0 ))??
 
 THE ACCOMPANYING PROGRAM IS PROVIDED UNDER THE TERMS OF THIS PUBLIC LICENSE
@@ -44508,6 +45815,7 @@ http://plan9.bell-labs.com/plan9/license.html
 https://opensource.org/licenses/LPL-1.02
 **//
 
+
 (( Lucent Public License Version 1.02 ))??
 
 THE ACCOMPANYING PROGRAM IS PROVIDED UNDER THE TERMS OF THIS PUBLIC LICENSE
@@ -46014,6 +47322,7 @@ http://www.latex-project.org/lppl/lppl-1-3c.txt
 https://opensource.org/licenses/LPPL-1.3c
 **//
 
+
 (( The LaTeX Project Public License
 
 =-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=-
@@ -46481,6 +47790,8 @@ https://forge.gouv.qc.ca/licence/fr/liliq-v1-1/
 http://opensource.org/licenses/LiLiQ-P-1.1
 **//
 
+
+
 (( Licence Libre du Québec – Permissive (LiLiQ-P)
 
 Version 1.1 ))??
@@ -46666,6 +47977,8 @@ https://www.forge.gouv.qc.ca/participez/licence-logicielle/licence-libre-du-queb
 http://opensource.org/licenses/LiLiQ-R-1.1
 **//
 
+
+
 (( Licence Libre du Québec – Réciprocité (LiLiQ-R)
 
 Version 1.1 ))??
@@ -46915,6 +48228,8 @@ https://www.forge.gouv.qc.ca/participez/licence-logicielle/licence-libre-du-queb
 http://opensource.org/licenses/LiLiQ-Rplus-1.1
 **//
 
+
+
 (( Licence Libre du Québec – Réciprocité forte (LiLiQ-R+)
 
 Version 1.1 ))??
@@ -47294,12 +48609,36 @@ are permitted provided that the following conditions are met:
    this list of conditions and the following disclaimer in the documentation
    and/or other materials provided with the distribution.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
-COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
-IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
-CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 `
 const license_MIT_lre = `
 
@@ -47316,6 +48655,7 @@ https://spdx.org/licenses/MIT.json
 https://opensource.org/licenses/MIT
 https://fedoraproject.org/wiki/Licensing:MIT
 **//
+
 (( MIT License))??
 //**Copyright**//
 
@@ -47412,6 +48752,63 @@ OR THE USE OR OTHER DEALINGS IN
 
 
 
+`
+const license_MIT_Fragment_lre = `//**
+MIT License, opening sentence standing alone
+such as "Permission is hereby granted, free of charge, to any person obtaining a copy..."
+**//
+
+
+
+
+//**
+	The full opening clause, including "free of charge", is required,
+	unlike in mit-grant, so that the fragment is long enough
+	not to match the first words of some other permission statement.
+	A text that goes on to copy more of the MIT license is not a fragment
+	(see Match.IsFragment), so the fragment stops well short of
+	'to deal in the Software without restriction', where MIT variants
+	with changed terms often begin to depart from it.
+**//
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+((of this software))??
+`
+const license_MIT_Grant_lre = `//**
+MIT License, granted in prose
+such as "This project is released under the terms of the MIT license."
+**//
+
+
+
+
+((
+	((This || The || Our || All))
+	((project || software || code || source code || library || program || package || module || repository || work))
+	((is || are))
+))??
+((
+	licensed under
+||
+	released under
+||
+	distributed under
+||
+	available under
+||
+	made available under
+||
+	provided under
+||
+	published under
+))
+((the terms of || the terms and conditions of))??
+((the))??
+
+((MIT || Expat))
+license
+
+
 `
 const license_MIT_0_lre = `
 //**
@@ -47422,6 +48819,7 @@ https://romanrm.net/mit-zero
 https://github.com/awsdocs/aws-cloud9-user-guide/blob/master/LICENSE-SAMPLECODE
 **//
 
+
 Permission is hereby granted,
 ((free of charge))??
 to any person obtaining a copy of
@@ -47638,12 +49036,36 @@ copies of the Software, its documentation and marketing & publicity materials,
 and acknowledgment shall be given in the documentation, materials and software
 packages that this Software was used.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS BE
-LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF
-CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
-SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 `
 const license_MIT_enna_lre = `//**
 enna License
@@ -47673,12 +49095,36 @@ software provided.
 Please see the COPYING.PLAIN for a plain-english explanation of this notice and
 it's intent.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS BE
-LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF
-CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
-SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 `
 const license_MIT_feh_lre = `//**
 feh License
@@ -47697,12 +49143,36 @@ The above copyright notice and this permission notice shall be included in all
 copies of the Software and its documentation and acknowledgment shall be given
 in the documentation and software packages that this Software was used.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS BE
-LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF
-CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
-SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 `
 const license_MITNFA_lre = `
 //**
@@ -47813,6 +49283,7 @@ http://www.mozilla.org/MPL/MPL-1.0.html
 https://opensource.org/licenses/MPL-1.0
 **//
 
+
 ((
 
 The contents of this file are subject to the Mozilla Public License
@@ -48233,6 +49704,7 @@ http://www.mozilla.org/MPL/MPL-1.1.html
 https://opensource.org/licenses/MPL-1.1
 **//
 
+
 ((
 
 The contents of this file are subject to the Mozilla Public License
@@ -48765,6 +50237,7 @@ https://opensource.org/licenses/MPL-2.0
 
 
 
+
 ((
 
 This Source Code Form is subject to the terms of the Mozilla Public License, v.
@@ -49171,10 +50644,51 @@ Exhibit B - "Incompatible With Secondary Licenses" Notice
 This Source Code Form is "Incompatible With Secondary Licenses", as defined by
 the Mozilla Public License, v. 2.0. ))??
 
+))
+`
+const license_MPL_2_0_Grant_lre = `//**
+Mozilla Public License 2.0, granted in prose
+such as "This code is made available under the Mozilla Public License, v. 2.0."
+**//
+
+
+
+
+((
+	((This || The || Our || All))
+	((project || software || code || source code || library || program || package || module || repository || work))
+	((is || are))
+))??
+((
+	licensed under
+||
+	released under
+||
+	distributed under
+||
+	available under
+||
+	made available under
+||
+	provided under
+||
+	published under
+))
+((the terms of || the terms and conditions of))??
+((the))??
+
+((
+	Mozilla Public License
+	((Version 2.0 || v. 2.0 || v2.0 || 2.0))
+||
+	MPL
+	((2.0 || v2.0 || v2))
+	((license))??
 ))
 `
 const license_MPL_2_0_no_copyleft_exception_lre = `
 
+
 This Source Code Form is subject to the terms of the Mozilla Public License, v.
 2.0. If a copy of the MPL was not distributed with this
 ((file || project))
@@ -49190,7 +50704,13 @@ http://www.microsoft.com/opensource/licenses.mspx
 https://opensource.org/licenses/MS-PL
 **//
 
-(( Microsoft Public License (Ms-PL) ))??
+
+((
+	Microsoft
+	//** Ms-PL was called the Microsoft Permissive License until 2007. **//
+	(( Public || Permissive ))
+	License (Ms-PL)
+))??
 
 This license governs use of the accompanying software. If you use the software,
 you accept this license. If you do not accept the license, do not use the
@@ -49256,6 +50776,24 @@ software.
       contributors exclude the implied warranties of merchantability, fitness
       for a particular purpose and non-infringement.
 `
+const license_MS_PL_Notice_lre = `
+//**
+Microsoft Public License, source file header
+**//
+
+
+	 
+	 
+
+	This source is subject to the Microsoft Public License
+	(( (Ms-PL) ))??
+	((
+		//** The URL, usually a go.microsoft.com link, varies. **//
+		Please see __10__ for details.
+		(( All other rights reserved. ))??
+	))??
+
+`
 const license_MS_RL_lre = `//**
 Microsoft Reciprocal License
 https://spdx.org/licenses/MS-RL.json
@@ -49263,6 +50801,7 @@ http://www.microsoft.com/opensource/licenses.mspx
 https://opensource.org/licenses/MS-RL
 **//
 
+
 (( Microsoft Reciprocal License (Ms-RL) ))??
 
 This license governs use of the accompanying software. If you use the software,
@@ -49305,6 +50844,10 @@ software.
    (( 3. ))??
    Conditions and Limitations
 
+      //**
+      The reciprocal grant is all that distinguishes Ms-RL from Ms-PL,
+      so it is required, and a copy without it matches Ms-PL instead.
+      **//
       (( (A) ))??
       Reciprocal Grants- For any file you distribute that contains code from the
       software (in source code or binary format), you must provide recipients
@@ -49342,6 +50885,24 @@ software.
       contributors exclude the implied warranties of merchantability, fitness
       for a particular purpose and non-infringement.
 `
+const license_MS_RL_Notice_lre = `
+//**
+Microsoft Reciprocal License, source file header
+**//
+
+
+	 
+	 
+
+	This source is subject to the Microsoft Reciprocal License
+	(( (Ms-RL) ))??
+	((
+		//** The URL, usually a go.microsoft.com link, varies. **//
+		Please see __10__ for details.
+		(( All other rights reserved. ))??
+	))??
+
+`
 const license_MTLL_lre = `//**
 Matrix Template Library License
 https://spdx.org/licenses/MTLL.json
@@ -49454,6 +51015,7 @@ https://spdx.org/licenses/MirOS.json
 https://opensource.org/licenses/MirOS
 **//
 
+
 (( The MirOS Licence
 (( Copyright __20__ ))??
 ))??
@@ -49477,6 +51039,7 @@ https://spdx.org/licenses/Motosoto.json
 https://opensource.org/licenses/Motosoto
 **//
 
+
 (( MOTOSOTO OPEN SOURCE LICENSE - Version 0.9.1 ))??
 
 This Motosoto Open Source License (the "License") applies to "Community Portal
@@ -49877,6 +51440,12 @@ https://license.coscl.org.cn/MulanPSL/
 https://github.com/yuwenlong/longphp/blob/25dfb70cc2a466dc4bb55ba30901cbce08d164b5/LICENSE
 **//
 
+
+
+
+
+((
+
 (( 木兰宽松许可证, 第1版 ))??
 
 (( 木兰宽松许可证， 第1版
@@ -49954,6 +51523,9 @@ EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT,
 MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
 
 See the Mulan PSL v1 for more details.
+
+((
+
 (( Mulan Permissive Software License，Version 1 ))??
 
 (( Mulan Permissive Software License，Version 1 (Mulan PSL v1)
@@ -50074,6 +51646,154 @@ EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT,
 MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
 
 See the Mulan PSL v1 for more details.
+
+))??
+||
+
+(( Mulan Permissive Software License，Version 1 ))??
+
+(( Mulan Permissive Software License，Version 1 (Mulan PSL v1)
+
+August 2019 http:/license.coscl.org.cn/MulanPSL ))??
+
+Your reproduction, use, modification and distribution of the Software shall be
+subject to Mulan PSL v1 (this License) with following terms and conditions:
+
+   (( 0. ))??
+   Definition
+
+   Software means the program and related documents which are comprised of those
+   Contribution and licensed under this License.
+
+   Contributor means the Individual or Legal Entity who licenses its
+   copyrightable work under this License.
+
+   Legal Entity means the entity making a Contribution and all its Affiliates.
+
+   Affiliates means entities that control, or are controlled by, or are under
+   common control with a party to this License, 'control' means direct or
+   indirect ownership of at least fifty percent (50%) of the voting power,
+   capital or other securities of controlled or commonly controlled entity.
+
+   Contribution means the copyrightable work licensed by a particular
+   Contributor under this License.
+
+   (( 1. ))??
+   Grant of Copyright License
+
+   Subject to the terms and conditions of this License, each Contributor hereby
+   grants to you a perpetual, worldwide, royalty-free, non-exclusive,
+   irrevocable copyright license to reproduce, use, modify, or distribute its
+   Contribution, with modification or not.
+
+   (( 2. ))??
+   Grant of Patent License
+
+   Subject to the terms and conditions of this License, each Contributor hereby
+   grants to you a perpetual, worldwide, royalty-free, non-exclusive,
+   irrevocable (except for revocation under this Section) patent license to
+   make, have made, use, offer for sale, sell, import or otherwise transfer its
+   Contribution where such patent license is only limited to the patent claims
+   owned or controlled by such Contributor now or in future which will be
+   necessarily infringed by its Contribution alone, or by combination of the
+   Contribution with the Software to which the Contribution was contributed,
+   excluding of any patent claims solely be infringed by your or others'
+   modification or other combinations. If you or your Affiliates directly or
+   indirectly (including through an agent, patent licensee or assignee）,
+   institute patent litigation (including a cross claim or counterclaim in a
+   litigation) or other patent enforcement activities against any individual or
+   entity by alleging that the Software or any Contribution in it infringes
+   patents, then any patent license granted to you under this License for the
+   Software shall terminate as of the date such litigation or activity is filed
+   or taken.
+
+   (( 3. ))??
+   No Trademark License
+
+   No trademark license is granted to use the trade names, trademarks, service
+   marks, or product names of Contributor, except as required to fulfill notice
+   requirements in section 4.
+
+   (( 4. ))??
+   Distribution Restriction
+
+   You may distribute the Software in any medium with or without modification,
+   whether in source or executable forms, provided that you provide recipients
+   with a copy of this License and retain copyright, patent, trademark and
+   disclaimer statements in the Software.
+
+   (( 5. ))??
+   Disclaimer of Warranty and Limitation of Liability
+
+   The Software and Contribution in it are provided without warranties of any
+   kind, either express or implied. In no event shall any Contributor or
+   copyright holder be liable to you for any damages,including, but not limited
+   to any direct, or indirect, special or consequential damages arising from
+   your use or inability to use the Software or the Contribution in it, no
+   matter how it's caused or based on which legal theory, even if advised of the
+   possibility of such damages.
+
+End of the Terms and Conditions
+
+How to apply the Mulan Permissive Software License，Version 1 (Mulan PSL v1) to
+your software
+
+To apply the Mulan PSL v1 to your work, for easy identification by recipients,
+you are suggested to complete following three steps:
+
+   (( i. ))??
+   Fill in the blanks in following statement, including insert your software
+   name, the year of the first publication of your software, and your name
+   identified as the copyright owner;
+
+   (( ii. ))??
+   Create a file named "LICENSE" which contains the whole context of this
+   License in the first directory of your software package;
+
+   (( iii. ))??
+   Attach the statement to the appropriate annotated syntax at the beginning of
+   each source file.
+
+Copyright (c) [2019] [name of copyright holder]
+
+[Software Name] is licensed under the Mulan PSL v1.
+
+You can use this software according to the terms and conditions of the Mulan PSL
+v1.
+
+You may obtain a copy of Mulan PSL v1 at:
+
+http:/license.coscl.org.cn/MulanPSL
+
+THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND,
+EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT,
+MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+
+See the Mulan PSL v1 for more details.
+
+))
+`
+const license_MulanPSL_1_0_Notice_lre = `
+//**
+Mulan Permissive Software License, Version 1, licensing notice
+such as "[Software Name] is licensed under the Mulan PSL v1."
+**//
+
+
+
+is licensed under
+((the))??
+Mulan PSL v1.
+You can use this software according to the terms and conditions of the Mulan PSL v1.
+You may obtain a copy of Mulan PSL v1 at:
+http:/license.coscl.org.cn/MulanPSL
+((
+	THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND,
+	EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT,
+	MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+	See the Mulan PSL v1 for more details.
+))??
+
 `
 const license_MulanPSL_2_0_lre = `//**
 Mulan Permissive Software License, Version 2
@@ -50081,6 +51801,13 @@ https://spdx.org/licenses/MulanPSL-2.0.json
 https://license.coscl.org.cn/MulanPSL2/
 **//
 
+
+
+
+
+
+((
+
 (( 木兰宽松许可证, 第2版 ))??
 
 (( 木兰宽松许可证， 第2版
@@ -50169,6 +51896,143 @@ EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT,
 MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
 
 See the Mulan PSL v2 for more details.
+
+((
+
+(( Mulan Permissive Software License，Version 2 ))??
+
+(( Mulan Permissive Software License，Version 2 (Mulan PSL v2)
+
+January 2020 http:/license.coscl.org.cn/MulanPSL2 ))??
+
+Your reproduction, use, modification and distribution of the Software shall be
+subject to Mulan PSL v2 (this License) with the following terms and conditions:
+
+   (( 0. ))??
+   Definition
+
+   Software means the program and related documents which are licensed under
+   this License and comprise all Contribution(s).
+
+   Contribution means the copyrightable work licensed by a particular
+   Contributor under this License.
+
+   Contributor means the Individual or Legal Entity who licenses its
+   copyrightable work under this License.
+
+   Legal Entity means the entity making a Contribution and all its Affiliates.
+
+   Affiliates means entities that control, are controlled by, or are under
+   common control with the acting entity under this License, 'control' means
+   direct or indirect ownership of at least fifty percent (50%) of the voting
+   power, capital or other securities of controlled or commonly controlled
+   entity.
+
+   (( 1. ))??
+   Grant of Copyright License
+
+   Subject to the terms and conditions of this License, each Contributor hereby
+   grants to you a perpetual, worldwide, royalty-free, non-exclusive,
+   irrevocable copyright license to reproduce, use, modify, or distribute its
+   Contribution, with modification or not.
+
+   (( 2. ))??
+   Grant of Patent License
+
+   Subject to the terms and conditions of this License, each Contributor hereby
+   grants to you a perpetual, worldwide, royalty-free, non-exclusive,
+   irrevocable (except for revocation under this Section) patent license to
+   make, have made, use, offer for sale, sell, import or otherwise transfer its
+   Contribution, where such patent license is only limited to the patent claims
+   owned or controlled by such Contributor now or in future which will be
+   necessarily infringed by its Contribution alone, or by combination of the
+   Contribution with the Software to which the Contribution was contributed. The
+   patent license shall not apply to any modification of the Contribution, and
+   any other combination which includes the Contribution. If you or your
+   Affiliates directly or indirectly institute patent litigation (including a
+   cross claim or counterclaim in a litigation) or other patent enforcement
+   activities against any individual or entity by alleging that the Software or
+   any Contribution in it infringes patents, then any patent license granted to
+   you under this License for the Software shall terminate as of the date such
+   litigation or activity is filed or taken.
+
+   (( 3. ))??
+   No Trademark License
+
+   No trademark license is granted to use the trade names, trademarks, service
+   marks, or product names of Contributor, except as required to fulfill notice
+   requirements in section 4.
+
+   (( 4. ))??
+   Distribution Restriction
+
+   You may distribute the Software in any medium with or without modification,
+   whether in source or executable forms, provided that you provide recipients
+   with a copy of this License and retain copyright, patent, trademark and
+   disclaimer statements in the Software.
+
+   (( 5. ))??
+   Disclaimer of Warranty and Limitation of Liability
+
+   THE SOFTWARE AND CONTRIBUTION IN IT ARE PROVIDED WITHOUT WARRANTIES OF ANY
+   KIND, EITHER EXPRESS OR IMPLIED. IN NO EVENT SHALL ANY CONTRIBUTOR OR
+   COPYRIGHT HOLDER BE LIABLE TO YOU FOR ANY DAMAGES, INCLUDING, BUT NOT LIMITED
+   TO ANY DIRECT, OR INDIRECT, SPECIAL OR CONSEQUENTIAL DAMAGES ARISING FROM
+   YOUR USE OR INABILITY TO USE THE SOFTWARE OR THE CONTRIBUTION IN IT, NO
+   MATTER HOW IT'S CAUSED OR BASED ON WHICH LEGAL THEORY, EVEN IF ADVISED OF THE
+   POSSIBILITY OF SUCH DAMAGES.
+
+   (( 6. ))??
+   Language
+
+   THIS LICENSE IS WRITTEN IN BOTH CHINESE AND ENGLISH, AND THE CHINESE VERSION
+   AND ENGLISH VERSION SHALL HAVE THE SAME LEGAL EFFECT. IN THE CASE OF
+   DIVERGENCE BETWEEN THE CHINESE AND ENGLISH VERSIONS, THE CHINESE VERSION
+   SHALL PREVAIL.
+
+END OF THE TERMS AND CONDITIONS
+
+How to Apply the Mulan Permissive Software License，Version 2 (Mulan PSL v2) to
+Your Software
+
+To apply the Mulan PSL v2 to your work, for easy identification by recipients,
+you are suggested to complete following three steps:
+
+   (( i. ))??
+   Fill in the blanks in following statement, including insert your software
+   name, the year of the first publication of your software, and your name
+   identified as the copyright owner;
+
+   (( ii. ))??
+   Create a file named "LICENSE" which contains the whole context of this
+   License in the first directory of your software package;
+
+   (( iii. ))??
+   Attach the statement to the appropriate annotated syntax at the beginning of
+   each source file.
+
+Copyright (c) [Year] [name of copyright holder]
+
+[Software Name] is licensed under Mulan PSL v2.
+
+You can use this software according to the terms and conditions of the Mulan PSL
+v2.
+
+You may obtain a copy of Mulan PSL v2 at:
+
+http:/license.coscl.org.cn/MulanPSL2
+
+THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND,
+
+EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT,
+
+MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+
+See the Mulan PSL v2 for more details.
+
+))??
+||
+
 (( Mulan Permissive Software License，Version 2 ))??
 
 (( Mulan Permissive Software License，Version 2 (Mulan PSL v2)
@@ -50299,6 +52163,30 @@ EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT,
 MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
 
 See the Mulan PSL v2 for more details.
+
+))
+`
+const license_MulanPSL_2_0_Notice_lre = `
+//**
+Mulan Permissive Software License, Version 2, licensing notice
+such as "[Software Name] is licensed under Mulan PSL v2."
+**//
+
+
+
+is licensed under
+((the))??
+Mulan PSL v2.
+You can use this software according to the terms and conditions of the Mulan PSL v2.
+You may obtain a copy of Mulan PSL v2 at:
+http:/license.coscl.org.cn/MulanPSL2
+((
+	THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND,
+	EITHER EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT,
+	MERCHANTABILITY OR FIT FOR A PARTICULAR PURPOSE.
+	See the Mulan PSL v2 for more details.
+))??
+
 `
 const license_Multics_lre = `//**
 Multics License
@@ -50306,6 +52194,7 @@ https://spdx.org/licenses/Multics.json
 https://opensource.org/licenses/Multics
 **//
 
+
 (( Multics License ))??
 
 (( Historical Background
@@ -50386,6 +52275,7 @@ http://ti.arc.nasa.gov/opensource/nosa/
 https://opensource.org/licenses/NASA-1.3
 **//
 
+
 (( NASA OPEN SOURCE AGREEMENT VERSION 1.3 ))??
 
 THIS OPEN SOURCE AGREEMENT ("AGREEMENT") DEFINES THE RIGHTS OF USE,
@@ -51006,6 +52896,7 @@ http://otm.illinois.edu/uiuc_openSource
 https://opensource.org/licenses/NCSA
 **//
 
+
 (( University of Illinois/NCSA Open Source License
 (( Copyright __20__ ))??
 ))??
@@ -51047,6 +52938,7 @@ https://spdx.org/licenses/NGPL.json
 https://opensource.org/licenses/NGPL
 **//
 
+
 (( NETHACK GENERAL PUBLIC LICENSE
 (( Copyright __20__ ))??
 ))??
@@ -53052,6 +54944,7 @@ https://spdx.org/licenses/NPOSL-3.0.json
 https://opensource.org/licenses/NOSL3.0
 **//
 
+
 (( Non-Profit Open Software License 3.0 ))??
 
 This Non-Profit Open Software License ("Non-Profit OSL") version 3.0 (the
@@ -53364,6 +55257,7 @@ https://spdx.org/licenses/NTP.json
 https://opensource.org/licenses/NTP
 **//
 
+
 (( NTP License (NTP)
 (( Copyright __20__ ))??
 ))??
@@ -53409,6 +55303,7 @@ https://spdx.org/licenses/Naumen.json
 https://opensource.org/licenses/Naumen
 **//
 
+
 (( NAUMEN Public License
 (( This software is ))??
 (( Copyright __20__ ))??
@@ -53804,12 +55699,61 @@ restrictions:
    Altered versions must be plainly marked as such, and must not be
    misrepresented as being the original software.
 `
+const license_NoLicense_lre = `//**
+No license: a statement that the work is proprietary or that no rights are granted
+such as "Unauthorized copying of this file, via any medium, is strictly prohibited."
+**//
+
+
+//**
+	A bare "All rights reserved" is not enough: it also ends the copyright
+	lines of many licenses that do grant rights, such as BSD-3-Clause.
+	Each alternative below says outright that no rights are granted.
+**//
+
+((
+	((Proprietary and confidential.))??
+	Unauthorized copying of this file, via any medium,
+	((is || are))
+	strictly prohibited.
+	((Proprietary and confidential.))??
+||
+	No part of this
+	((software || code || program || file || work || project || repository))
+	may be
+	((reproduced || copied || used))
+	((distributed || modified))
+	((or))??
+	((transmitted || distributed || disclosed))
+	((in any form or by any means))??
+	((, including photocopying, recording, or other electronic or mechanical methods,))??
+	without the prior written
+	((permission || consent))
+||
+	((This || The))
+	((software || code || program || file || project || repository || work))
+	is
+	((not licensed || unlicensed || proprietary))
+	and
+	((no license || no permission || no rights))
+	((is || are))
+	granted
+	((to use, copy, modify, or distribute it))??
+	.
+||
+	No license is granted to use, copy, modify, merge, publish, distribute,
+	sublicense, or sell copies of this
+	((software || code || program || file || project || repository || work))
+	.
+))
+`
 const license_Nokia_lre = `//**
 Nokia Open Source License
 https://spdx.org/licenses/Nokia.json
 https://opensource.org/licenses/nokia
 **//
 
+
 (( Nokia Open Source License (NOKOS License)
 
 Version 1.0a ))??
@@ -54707,6 +56651,7 @@ http://www.oclc.org/research/activities/software/license/v2final.htm
 https://opensource.org/licenses/OCLC-2.0
 **//
 
+
 (( OCLC Research Public License 2.0
 Terms & Conditions Of Use
 May, 2002
@@ -56020,6 +57965,19 @@ https://spdx.org/licenses/OFL-1.0.json
 http://scripts.sil.org/cms/scripts/page.php?item_id=OFL10_web
 **//
 
+
+//**
+A font's copyright line is often followed by the names it reserves,
+as in "with Reserved Font Name 'Source'."
+**//
+((
+	((with))??
+	Reserved Font
+	((Name || Names))
+	__6__
+))??
+
+
 (( SIL OPEN FONT LICENSE
 
 Version 1.0 - 22 November 2005 ))??
@@ -56130,8 +58088,22 @@ http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web
 https://opensource.org/licenses/OFL-1.1
 **//
 
+
 //** Copyright **//
 
+
+//**
+A font's copyright line is often followed by the names it reserves,
+as in "with Reserved Font Name 'Source'."
+**//
+((
+	((with))??
+	Reserved Font
+	((Name || Names))
+	__6__
+))??
+
+
 (( This Font Software is licensed under the SIL Open Font License, Version 1.1.
 
 This license is copied below, and is also available with a FAQ at:
@@ -56230,6 +58202,8 @@ DAMAGES OR OTHER LIABILITY, INCLUDING ANY GENERAL, SPECIAL, INDIRECT,
 INCIDENTAL, OR CONSEQUENTIAL DAMAGES, WHETHER IN AN ACTION OF CONTRACT, TORT OR
 OTHERWISE, ARISING FROM, OUT OF THE USE OR INABILITY TO USE THE FONT SOFTWARE OR
 FROM OTHER DEALINGS IN THE FONT SOFTWARE.
+
+
 `
 const license_OGC_1_0_lre = `//**
 OGC Software License, Version 1.0
@@ -56877,6 +58851,7 @@ http://www.opengroup.org/testing/downloads/The_Open_Group_TSL.txt
 https://opensource.org/licenses/OGTSL
 **//
 
+
 (( The Open Group Test Suite License ))??
 
 (( Preamble
@@ -58179,6 +60154,7 @@ https://spdx.org/licenses/OLDAP-2.8.json
 http://www.openldap.org/software/release/license.html
 **//
 
+
 (( The OpenLDAP Public License
 
 Version 2.8, 17 August 2003 ))??
@@ -58706,6 +60682,7 @@ http://www.osetfoundation.org/public-license
 https://opensource.org/licenses/OPL-2.1
 **//
 
+
 (( OSET Public License
 (( Copyright __20__ ))??
 ))??
@@ -59213,6 +61190,7 @@ https://spdx.org/licenses/OSL-1.0.json
 https://opensource.org/licenses/OSL-1.0
 **//
 
+
 (( The Open Software License v. 1.0 ))??
 
 This Open Software License (the "License") applies to any original work of
@@ -59384,6 +61362,30 @@ This license is Copyright (C) 2002 Lawrence E. Rosen. All rights reserved.
 Permission is hereby granted to copy and distribute this license without
 modification. This license may not be modified without the express written
 permission of its copyright owner.
+`
+const license_OSL_1_0_Notice_lre = `
+//**
+Open Software License 1.0, licensing notice
+such as "Licensed under the Open Software License version 1.0"
+**//
+
+
+((
+	Licensed under the Open Software License
+	((version || v.))??
+	1.0
+||
+	This source file is subject to the Open Software License
+	(OSL 1.0)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_OSL_1_1_lre = `//**
 Open Software License 1.1
@@ -59573,6 +61575,30 @@ This license is Copyright (C) 2002 Lawrence E. Rosen. All rights reserved.
 Permission is hereby granted to copy and distribute this license without
 modification. This license may not be modified without the express written
 permission of its copyright owner.
+`
+const license_OSL_1_1_Notice_lre = `
+//**
+Open Software License 1.1, licensing notice
+such as "Licensed under the Open Software License version 1.1"
+**//
+
+
+((
+	Licensed under the Open Software License
+	((version || v.))??
+	1.1
+||
+	This source file is subject to the Open Software License
+	(OSL 1.1)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_OSL_2_0_lre = `//**
 Open Software License 2.0
@@ -59580,6 +61606,7 @@ https://spdx.org/licenses/OSL-2.0.json
 http://web.archive.org/web/20041020171434/http://www.rosenlaw.com/osl2.0.html
 **//
 
+
 ((
 (( The ))??
 Open Software License v. 2.0 ))??
@@ -59767,6 +61794,30 @@ This license is Copyright (C) 2003 Lawrence E. Rosen. All rights reserved.
 Permission is hereby granted to copy and distribute this license without
 modification. This license may not be modified without the express written
 permission of its copyright owner.
+`
+const license_OSL_2_0_Notice_lre = `
+//**
+Open Software License 2.0, licensing notice
+such as "Licensed under the Open Software License version 2.0"
+**//
+
+
+((
+	Licensed under the Open Software License
+	((version || v.))??
+	2.0
+||
+	This source file is subject to the Open Software License
+	(OSL 2.0)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_OSL_2_1_lre = `//**
 Open Software License 2.1
@@ -59775,7 +61826,8 @@ http://web.archive.org/web/20050212003940/http://www.rosenlaw.com/osl21.htm
 https://opensource.org/licenses/OSL-2.1
 **//
 
-(( The Open Software Licensev. 2.1 ))??
+
+(( The Open Software License v. 2.1 ))??
 
 This Open Software License (the "License") applies to any original work of
 authorship (the "Original Work") whose owner (the "Licensor") has placed the
@@ -59961,6 +62013,30 @@ This license is Copyright (C) 2003-2004 Lawrence E. Rosen. All rights reserved.
 Permission is hereby granted to copy and distribute this license without
 modification. This license may not be modified without the express written
 permission of its copyright owner.
+`
+const license_OSL_2_1_Notice_lre = `
+//**
+Open Software License 2.1, licensing notice
+such as "Licensed under the Open Software License version 2.1"
+**//
+
+
+((
+	Licensed under the Open Software License
+	((version || v.))??
+	2.1
+||
+	This source file is subject to the Open Software License
+	(OSL 2.1)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_OSL_3_0_lre = `//**
 Open Software License 3.0
@@ -59969,6 +62045,7 @@ https://web.archive.org/web/20120101081418/http://rosenlaw.com:80/OSL3.0.htm
 https://opensource.org/licenses/OSL-3.0
 **//
 
+
 ((
 	((The))??
 	Open Software License
@@ -60169,6 +62246,30 @@ Licensed under the Open Software License version 3.0
    source software unless your Modified License has been approved by Open Source
    Initiative (OSI) and You comply with its license review and certification
    process.
+`
+const license_OSL_3_0_Notice_lre = `
+//**
+Open Software License 3.0, licensing notice
+such as "Licensed under the Open Software License version 3.0"
+**//
+
+
+((
+	Licensed under the Open Software License
+	((version || v.))??
+	3.0
+||
+	This source file is subject to the Open Software License
+	(OSL 3.0)
+	((
+		that is bundled with this package in the file __5__
+		It is also available through the world-wide-web at this URL: __10__
+		If you did not receive a copy of the license and are unable to
+		obtain it through the world-wide-web, please send an email
+		to __5__ so we can send you a copy immediately.
+	))??
+))
+
 `
 const license_OpenSSL_lre = `//**
 OpenSSL License
@@ -60176,6 +62277,24 @@ https://spdx.org/licenses/OpenSSL.json
 http://www.openssl.org/source/license.html
 **//
 
+((
+	((
+		LICENSE ISSUES
+	||
+		OpenSSL License
+	))??
+	The OpenSSL toolkit stays under a
+	((dual || double))
+	license, i.e. both the conditions of the OpenSSL License and the original
+	SSLeay license apply to the toolkit. See below for the actual license texts.
+	((
+		Actually both licenses are BSD-style Open Source licenses. In case of
+		any license issues related to OpenSSL please contact openssl-core@openssl.org.
+	))??
+	(( OpenSSL License ))??
+	(( Copyright __20__ ))??
+))??
+
 (( OpenSSL License
 (( Copyright __20__ ))??
 ))??
@@ -60234,6 +62353,7 @@ This product includes cryptographic software written by Eric Young
 Copyright __5__ Eric Young  (eay@cryptsoft.com)
 All rights reserved.
 
+
 This package is an SSL implementation written by Eric Young (eay@cryptsoft.com).
 The implementation was written so as to conform with Netscapes SSL.
 
@@ -60287,6 +62407,7 @@ BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
 CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING
 IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY
 OF SUCH DAMAGE.
+
 ))??
 
 ((
@@ -60295,6 +62416,9 @@ derivative of this code cannot be changed. i.e. this code cannot simply be
 copied and put under another distribution licence [including the GNU Public
 Licence.]
 ))??
+
+
+
 `
 const license_PDDL_1_0_lre = `//**
 ODC Public Domain Dedication & License 1.0
@@ -60648,6 +62772,26 @@ Part III: General provisions
    waived or granted under this Document, these additional rights are included
    in this Document in order to meet the intent of this Document.
 `
+const license_PDM_1_0_Notice_lre = `//**
+Creative Commons Public Domain Mark 1.0, mark statement
+https://creativecommons.org/publicdomain/mark/1.0/
+**//
+
+
+
+((Public Domain Mark 1.0))??
+
+This work
+__20__
+((is || has been identified as being))
+free of known
+((copyright))??
+restrictions
+((
+	under copyright law,
+	including all related and neighboring rights
+))??
+`
 const license_PHP_3_0_lre = `//**
 PHP License v3.0
 https://spdx.org/licenses/PHP-3.0.json
@@ -60655,6 +62799,7 @@ http://www.php.net/license/3_0.txt
 https://opensource.org/licenses/PHP-3.0
 **//
 
+
 (( The PHP License, version 3.0
 (( Copyright __20__ ))??
 ))??
@@ -60726,6 +62871,7 @@ https://spdx.org/licenses/PHP-3.01.json
 http://www.php.net/license/3_01.txt
 **//
 
+
 (( The PHP License, version 3.01
 (( Copyright __20__ ))??
 ))??
@@ -60797,43 +62943,82 @@ https://spdx.org/licenses/PSF-2.0.json
 https://opensource.org/licenses/Python-2.0
 **//
 
-(( PYTHON SOFTWARE FOUNDATION LICENSE VERSION 2 ))??
+//**
+Projects such as matplotlib use this agreement with their own names
+in place of the Python Software Foundation ("PSF") and Python.
+**//
+
+((
+	PYTHON SOFTWARE FOUNDATION LICENSE VERSION 2
+||
+	License agreement for __10__
+))??
 
    (( 1. ))??
-   This LICENSE AGREEMENT is between the Python Software Foundation ("PSF"), and
+   This LICENSE AGREEMENT is between
+   ((the Python Software Foundation ("PSF") || __6__))
+   , and
    the Individual or Organization ("Licensee") accessing and otherwise using
-   this software ("Python") in source or binary form and its associated
+   ((this software ("Python") || __3__ software))
+   in source or binary form and its associated
    documentation.
 
    (( 2. ))??
-   Subject to the terms and conditions of this License Agreement, PSF hereby
+   Subject to the terms and conditions of this License Agreement,
+   ((PSF || __2__))
+   hereby
    grants Licensee a nonexclusive, royalty-free, world-wide license to
    reproduce, analyze, test, perform and/or display publicly, prepare derivative
-   works, distribute, and otherwise use Python alone or in any derivative
-   version, provided, however, that PSF's License Agreement and PSF's notice of
+   works, distribute, and otherwise use
+   ((Python || __3__))
+   alone or in any derivative
+   version, provided, however, that
+   ((PSF's || __2__))
+   License Agreement and
+   ((PSF's || __2__))
+   notice of
    copyright, i.e., "Copyright (c)
-   //** 2001, 2002, 2003, 2004, 2005, 2006 **//
-   __6__
-   Python Software Foundation; All Rights Reserved" are retained in Python alone
+   //** 2001, 2002, 2003, 2004, 2005, 2006 Python Software Foundation **//
+   __10__
+   All Rights Reserved" are retained in
+   ((Python || __3__))
+   alone
    or in any derivative version prepared by Licensee.
 
    (( 3. ))??
    In the event Licensee prepares a derivative work that is based on or
-   incorporates Python or any part thereof, and wants to make the derivative
+   incorporates
+   ((Python || __3__))
+   or any part thereof, and wants to make the derivative
    work available to others as provided herein, then Licensee hereby agrees to
-   include in any such work a brief summary of the changes made to Python.
+   include in any such work a brief summary of the changes made to
+   ((Python || __3__))
+   .
 
    (( 4. ))??
-   PSF is making Python available to Licensee on an "AS IS" basis. PSF MAKES NO
+   ((PSF || __2__))
+   is making
+   ((Python || __3__))
+   available to Licensee on an "AS IS" basis.
+   ((PSF || __2__))
+   MAKES NO
    REPRESENTATIONS OR WARRANTIES, EXPRESS OR IMPLIED. BY WAY OF EXAMPLE, BUT NOT
-   LIMITATION, PSF MAKES NO AND DISCLAIMS ANY REPRESENTATION OR WARRANTY OF
+   LIMITATION,
+   ((PSF || __2__))
+   MAKES NO AND DISCLAIMS ANY REPRESENTATION OR WARRANTY OF
    MERCHANTABILITY OR FITNESS FOR ANY PARTICULAR PURPOSE OR THAT THE USE OF
-   PYTHON WILL NOT INFRINGE ANY THIRD PARTY RIGHTS.
+   ((PYTHON || __3__))
+   WILL NOT INFRINGE ANY THIRD PARTY RIGHTS.
 
    (( 5. ))??
-   PSF SHALL NOT BE LIABLE TO LICENSEE OR ANY OTHER USERS OF PYTHON FOR ANY
+   ((PSF || __2__))
+   SHALL NOT BE LIABLE TO LICENSEE OR ANY OTHER USERS OF
+   ((PYTHON || __3__))
+   FOR ANY
    INCIDENTAL, SPECIAL, OR CONSEQUENTIAL DAMAGES OR LOSS AS A RESULT OF
-   MODIFYING, DISTRIBUTING, OR OTHERWISE USING PYTHON, OR ANY DERIVATIVE
+   MODIFYING, DISTRIBUTING, OR OTHERWISE USING
+   ((PYTHON || __3__))
+   , OR ANY DERIVATIVE
    THEREOF, EVEN IF ADVISED OF THE POSSIBILITY THEREOF.
 
    (( 6. ))??
@@ -60842,13 +63027,19 @@ https://opensource.org/licenses/Python-2.0
 
    (( 7. ))??
    Nothing in this License Agreement shall be deemed to create any relationship
-   of agency, partnership, or joint venture between PSF and Licensee. This
-   License Agreement does not grant permission to use PSF trademarks or trade
+   of agency, partnership, or joint venture between
+   ((PSF || __2__))
+   and Licensee. This
+   License Agreement does not grant permission to use
+   ((PSF || __2__))
+   trademarks or trade
    name in a trademark sense to endorse or promote products or services of
    Licensee, or any third party.
 
    (( 8. ))??
-   By copying, installing or otherwise using Python, Licensee agrees to be bound
+   By copying, installing or otherwise using
+   ((Python || __3__))
+   , Licensee agrees to be bound
    by the terms and conditions of this License Agreement.
 `
 const license_Parity_6_0_0_lre = `//**
@@ -61030,6 +63221,43 @@ No Liability
 condition, and the contributor won't be liable to anyone for any damages related
 to this software or this license, under any kind of legal claim.***
 `
+const license_Perl_lre = `//**
+Perl license: Artistic License 1.0 (Perl) OR GNU General Public License v1.0 or later
+https://dev.perl.org/licenses/
+Not an SPDX ID: the dual grant used by Perl and most CPAN modules,
+reported as the expression Artistic-1.0-Perl OR GPL-1.0-or-later.
+**//
+
+((
+	((This || The))
+	((library || program || module || package || software || code))??
+	is free software; you can redistribute it and/or modify it
+	under the same terms as
+	((
+		Perl
+		((5))??
+		itself
+	||
+		the Perl 5 programming language system itself
+	||
+		Perl
+	))
+||
+	((This || The))
+	((library || program || module || package || software || code))??
+	is free software; you can redistribute it and/or modify it
+	under the terms of either:
+
+	(( a) ))??
+	the GNU General Public License as published by the Free Software
+	Foundation; either version 1, or (at your option) any later version, or
+
+	(( b) ))??
+	the "Artistic License"
+	((which comes with this Kit || which comes with Perl))??
+	.
+))
+`
 const license_Plexus_lre = `//**
 Plexus Classworlds License
 https://spdx.org/licenses/Plexus.json
@@ -61338,6 +63566,7 @@ http://www.postgresql.org/about/licence
 https://opensource.org/licenses/PostgreSQL
 **//
 
+
 (( PostgreSQL Database Management System
 
 (formerly known as Postgres, then as Postgres95)
@@ -61469,6 +63698,82 @@ https://spdx.org/licenses/Python-2.0.json
 https://opensource.org/licenses/Python-2.0
 **//
 
+
+//**
+The Python distribution's LICENSE file begins with a history of the
+software and a table of the licenses of past releases, followed by
+the stack of licenses below in reverse chronological order.
+**//
+((
+	(( A. ))??
+	HISTORY OF THE SOFTWARE
+
+	Python was created in the early 1990s by Guido van Rossum at Stichting
+	Mathematisch Centrum (CWI, see __5__) in the Netherlands
+	as a successor of a language called ABC. Guido remains Python's
+	principal author, although it includes many contributions from others.
+
+	In 1995, Guido continued his work on Python at the Corporation for
+	National Research Initiatives (CNRI, see __8__)
+	in Reston, Virginia where he released several versions of the
+	software.
+
+	In May 2000, Guido and the Python core development team moved to
+	BeOpen.com to form the BeOpen PythonLabs team. In October of the same
+	year, the PythonLabs team moved to Digital Creations,
+	((which became Zope Corporation || now Zope Corporation))
+	((see __5__))??
+	In 2001, the Python Software Foundation (PSF, see
+	__6__) was formed, a non-profit organization
+	created specifically to own Python-related Intellectual Property.
+	Zope Corporation
+	((is || was))
+	a sponsoring member of the PSF.
+
+	All Python releases are Open Source (see __5__ for
+	the Open Source Definition). Historically, most, but not all, Python
+	releases have also been GPL-compatible; the table below summarizes
+	the various releases.
+
+	Release Derived Year Owner GPL- from compatible? (1)
+	__120__
+
+	Footnotes:
+
+	(1) GPL-compatible doesn't mean that we're distributing Python under
+	the GPL. All Python licenses, unlike the GPL, let you distribute
+	a modified version without making your changes open source. The
+	GPL-compatible licenses make it possible to combine Python with
+	other software that is released under the GPL; the others don't.
+
+	(2) According to Richard Stallman, 1.6.1 is not GPL-compatible,
+	because its license has a choice of law clause. According to
+	CNRI, however, Stallman's lawyer has told CNRI's lawyer that 1.6.1
+	is "not incompatible" with the GPL.
+
+	Thanks to the many outside volunteers who have worked under Guido's
+	direction to make these releases possible.
+
+	(( B. ))??
+	TERMS AND CONDITIONS FOR ACCESSING OR OTHERWISE USING PYTHON
+
+	((
+		Python software and documentation are licensed under the
+		Python Software Foundation License Version 2.
+	))??
+
+	((
+		Starting with Python __3__, examples, recipes, and other code in
+		the documentation are dual licensed under the PSF License Version 2
+		and the Zero-Clause BSD license.
+	))??
+
+	((
+		Some software incorporated into Python is under different licenses.
+		The licenses are listed with code falling under that license.
+	))??
+))??
+
 (( PYTHON SOFTWARE FOUNDATION LICENSE VERSION 2 ))??
 
    (( 1. ))??
@@ -61672,6 +63977,7 @@ http://doc.qt.nokia.com/3.3/license.html
 https://opensource.org/licenses/QPL-1.0
 **//
 
+
 (( THE Q PUBLIC LICENSE version 1.0
 (( Copyright __20__ ))??
 ))??
@@ -62271,6 +64577,7 @@ https://spdx.org/licenses/RPL-1.1.json
 https://opensource.org/licenses/RPL-1.1
 **//
 
+
 (( Reciprocal Public License, version 1.1
 (( Copyright __20__ ))??
 ))??
@@ -62944,6 +65251,7 @@ https://spdx.org/licenses/RPL-1.5.json
 https://opensource.org/licenses/RPL-1.5
 **//
 
+
 (( Reciprocal Public License 1.5 (RPL1.5)
 Version 1.5, July 15, 2007
 
@@ -63574,6 +65882,7 @@ https://helixcommunity.org/content/rpsl
 https://opensource.org/licenses/RPSL-1.0
 **//
 
+
 (( RealNetworks Public Source License Version 1.0
 
 (Rev. Date October 28, 2002) ))??
@@ -64240,6 +66549,7 @@ http://wayback.archive.org/web/20060715140826/http://www.risource.org/RPL/RPL-1.
 https://opensource.org/licenses/RSCPL
 **//
 
+
 (( Ricoh Source Code Public License
 
 Version 1.0 ))??
@@ -64716,6 +67026,29 @@ https://spdx.org/licenses/Ruby.json
 http://www.ruby-lang.org/en/LICENSE.txt
 **//
 
+//**
+Ruby is dual-licensed: the preamble offers either the Ruby conditions below
+or another license, the GPL in older releases and the BSD 2-Clause license
+("BSDL") since Ruby 1.9.3.
+**//
+((
+   Ruby is copyrighted free software by __8__.
+   You can redistribute it and/or modify it under either the terms of the
+   ((
+      2-clause BSDL (see the file BSDL)
+   ||
+      GPL
+      ((version 2))??
+      (see the file
+      ((GPL || COPYING || COPYING.txt || GPL.txt))
+      ((file))??
+      )
+   ||
+      GPL (see COPYING.txt file)
+   ))
+   , or the conditions below:
+))??
+
    (( 1. ))??
    You may make and give away verbatim copies of the source form of the software
    without restriction, provided that you
@@ -65655,12 +67988,36 @@ The above copyright notice including the dates of first publication and either
 this permission notice or a reference to http:/oss.sgi.com/projects/FreeB/ shall
 be included in all copies or substantial portions of the Software.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL SILICON
-GRAPHICS, INC. BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN
-AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
-WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 
 Except as contained in this notice, the name of Silicon Graphics, Inc. shall not
 be used in advertising or otherwise to promote the sale, use or other dealings
@@ -66091,6 +68448,7 @@ http://www.openoffice.org/licenses/sissl_license.html
 https://opensource.org/licenses/SISSL
 **//
 
+
 (( Sun Industry Standards Source License - Version 1.1 ))??
 
    (( 1.0 ))??
@@ -67274,6 +69632,7 @@ https://spdx.org/licenses/SPL-1.0.json
 https://opensource.org/licenses/SPL-1.0
 **//
 
+
 (( SUN PUBLIC LICENSE Version 1.0 ))??
 
    (( 1. ))??
@@ -67870,6 +70229,81 @@ marked as such, and if the derived work is incompatible with the protocol
 description in the RFC file, it must be called by a name other than "ssh" or
 "Secure Shell".
 `
+const license_SSLeay_standalone_lre = `//**
+SSLeay License - standalone
+https://spdx.org/licenses/SSLeay-standalone.json
+https://www.tq-group.com/filedownloads/files/software-license-conditions/OriginalSSLeay/OriginalSSLeay.pdf
+**//
+
+//**
+	The original SSLeay license, the second half of the OpenSSL dual license,
+	as found alone in the headers of the many source files of OpenSSL and
+	its forks that date from SSLeay.
+**//
+
+
+This package is an SSL implementation written by Eric Young (eay@cryptsoft.com).
+The implementation was written so as to conform with Netscapes SSL.
+
+This library is free for commercial and non-commercial use as long as the
+following conditions are aheared to. The following conditions apply to all code
+found in this distribution, be it the RC4, RSA, lhash, DES, etc., code; not just
+the SSL code. The SSL documentation included with this distribution is covered
+by the same copyright terms except that the holder is Tim Hudson
+(tjh@cryptsoft.com).
+
+Copyright remains Eric Young's, and as such any Copyright notices in the code
+are not to be removed. If this package is used in a product, Eric Young should
+be given attribution as the author of the parts of the library used. This can be
+in the form of a textual message at program startup or in documentation (online
+or textual) provided with the package.
+
+Redistribution and use in source and binary forms, with or without modification,
+are permitted provided that the following conditions are met:
+
+   (( 1. ))??
+   Redistributions of source code must retain the copyright notice, this list of
+   conditions and the following disclaimer.
+
+   (( 2. ))??
+   Redistributions in binary form must reproduce the above copyright notice,
+   this list of conditions and the following disclaimer in the documentation
+   and/or other materials provided with the distribution.
+
+   (( 3. ))??
+   All advertising materials mentioning features or use of this software must
+   display the following acknowledgement:
+
+   "This product includes cryptographic software written by Eric Young
+   (eay@cryptsoft.com)"
+
+   The word 'cryptographic' can be left out if the rouines from the library
+   being used are not cryptographic related :-).
+
+   (( 4. ))??
+   If you include any Windows specific code (or a derivative thereof) from the
+   apps directory (application code) you must include an acknowledgement: "This
+   product includes software written by Tim Hudson (tjh@cryptsoft.com)"
+
+THIS SOFTWARE IS PROVIDED BY ERIC YOUNG ` + "`" + `` + "`" + `AS IS'' AND ANY EXPRESS OR IMPLIED
+WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF
+MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT
+SHALL THE AUTHOR OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO,
+PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR
+BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING
+IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY
+OF SUCH DAMAGE.
+
+
+((
+The licence and distribution terms for any publically available version or
+derivative of this code cannot be changed. i.e. this code cannot simply be
+copied and put under another distribution licence [including the GNU Public
+Licence.]
+))??
+`
 const license_SSPL_1_0_lre = `//**
 Server Side Public License, v 1
 https://spdx.org/licenses/SSPL-1.0.json
@@ -68748,6 +71182,7 @@ https://spdx.org/licenses/SimPL-2.0.json
 https://opensource.org/licenses/SimPL-2.0
 **//
 
+
 (( Simple Public License (SimPL) ))??
 
 (( Preamble
@@ -68833,6 +71268,7 @@ https://spdx.org/licenses/Sleepycat.json
 https://opensource.org/licenses/Sleepycat
 **//
 
+
 (( The Sleepycat License
 (( Copyright __20__ ))??
 ))??
@@ -70159,6 +72595,7 @@ https://spdx.org/licenses/UCL-1.0.json
 https://opensource.org/licenses/UCL-1.0
 **//
 
+
 (( Upstream Compatibility License v. 1.0 (UCL-1.0) ))??
 
 This Upstream Compatibility License (the "License") applies to any original work
@@ -70364,6 +72801,7 @@ https://spdx.org/licenses/UPL-1.0.json
 https://opensource.org/licenses/UPL
 **//
 
+
 //** Copyright **//
 
 (( The Universal Permissive License (UPL), Version 1.0 ))??
@@ -70396,12 +72834,36 @@ The above copyright notice and either this complete permission notice or at a
 minimum a reference to the UPL must be included in all copies or substantial
 portions of the Software.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
-COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
-IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
-CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 `
 const license_Unicode_DFS_2015_lre = `//**
 Unicode License Agreement - Data Files and Software (2015)
@@ -70427,7 +72889,7 @@ NOT DOWNLOAD, INSTALL, COPY, DISTRIBUTE OR USE THE DATA FILES OR SOFTWARE.
 
 COPYRIGHT AND PERMISSION NOTICE
 
-Copyright © 1991-2015 Unicode, Inc. All rights reserved. Distributed under the
+Copyright __5__ Unicode, Inc. All rights reserved. Distributed under the
 Terms of Use in http:/www.unicode.org/copyright.html.
 
 Permission is hereby granted, free of charge, to any person obtaining a copy of
@@ -70482,21 +72944,28 @@ https://spdx.org/licenses/Unicode-DFS-2016.json
 http://www.unicode.org/copyright.html
 **//
 
-(( UNICODE, INC. LICENSE AGREEMENT - DATA FILES AND SOFTWARE ))??
-
-Unicode Data Files include all data files under the directories
-http:/www.unicode.org/Public/, http:/www.unicode.org/reports/,
-http:/www.unicode.org/cldr/data/, http:/source.icu-project.org/repos/icu/,
-(( http:/www.unicode.org/ivd/data/, ))??
-and http:/www.unicode.org/utility/trac/browser/.
 
-Unicode Data Files do not include PDF online code charts under the directory
-http:/www.unicode.org/Public/.
+(( UNICODE, INC. LICENSE AGREEMENT - DATA FILES AND SOFTWARE ))??
 
-Software includes any source code published in the Unicode Standard or under the
-directories http:/www.unicode.org/Public/, http:/www.unicode.org/reports/,
-http:/www.unicode.org/cldr/data/, http:/source.icu-project.org/repos/icu/, and
-http:/www.unicode.org/utility/trac/browser/.
+((
+	Unicode Data Files include all data files under the directories
+	http:/www.unicode.org/Public/, http:/www.unicode.org/reports/,
+	http:/www.unicode.org/cldr/data/, http:/source.icu-project.org/repos/icu/,
+	(( http:/www.unicode.org/ivd/data/, ))??
+	and http:/www.unicode.org/utility/trac/browser/.
+
+	Unicode Data Files do not include PDF online code charts under the directory
+	http:/www.unicode.org/Public/.
+
+	Software includes any source code published in the Unicode Standard or under the
+	directories http:/www.unicode.org/Public/, http:/www.unicode.org/reports/,
+	http:/www.unicode.org/cldr/data/, http:/source.icu-project.org/repos/icu/, and
+	http:/www.unicode.org/utility/trac/browser/.
+||
+	//** Later revisions, as bundled with ICU and CLDR, define the terms by reference. **//
+	See Terms of Use https:/www.unicode.org/copyright.html
+	for definitions of Unicode Inc.'s Data Files and Software.
+))
 
 NOTICE TO USER: Carefully read the following legal agreement. BY DOWNLOADING,
 INSTALLING, COPYING OR OTHERWISE USING UNICODE INC.'S DATA FILES ("DATA FILES"),
@@ -70554,7 +73023,7 @@ Trademark Usage Policy.
    Unicode Copyright.
 
       (( 1. ))??
-      Copyright © 1991-2014 Unicode, Inc. All rights reserved.
+      Copyright __5__ Unicode, Inc. All rights reserved.
 
       (( 2. ))??
       Certain documents and files on this website contain a legend indicating
@@ -70691,12 +73160,25 @@ Trademark Usage Policy.
       Entire Agreement. This Agreement constitutes the entire agreement between
       the parties.
 `
+const license_Unicode_TOU_Notice_lre = `//**
+Unicode Terms of Use, data file header
+as in UnicodeData.txt and the other files of the Unicode Character Database
+https://www.unicode.org/Public/UCD/latest/ucd/
+**//
+
+
+
+Unicode and the Unicode Logo are registered trademarks of Unicode, Inc.
+in the U.S. and other countries.
+For terms of use, see http:/www.unicode.org/terms_of_use.html
+`
 const license_Unlicense_lre = `//**
 The Unlicense
 https://spdx.org/licenses/Unlicense.json
 https://unlicense.org/
 **//
 
+
 ((This))??
 is free and unencumbered software released into the public domain.
 
@@ -70711,12 +73193,36 @@ the detriment of our heirs and successors. We intend this dedication to be an
 overt act of relinquishment in perpetuity of all present and future rights to
 this software under copyright law.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS BE
-LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF
-CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
-SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 
 (( For more information, please refer to <https:/unlicense.org/> ))??
 `
@@ -70799,6 +73305,7 @@ https://spdx.org/licenses/VSL-1.0.json
 https://opensource.org/licenses/VSL-1.0
 **//
 
+
 (( Vovida Software License v. 1.0 ))??
 
 (( This license applies to all software incorporated in the "Vovida Open
@@ -71002,6 +73509,43 @@ http://vimdoc.sourceforge.net/htmldoc/uganda.html
    __5__
    releases instead of the license that they came with, at your option.
 `
+const license_Vim_Grant_lre = `//**
+Vim License, granted in prose, as is common for Vim plugins and scripts,
+such as "This file is placed under the same license as Vim itself."
+**//
+
+
+
+((
+	((
+		((This || The))
+		((file || plugin || script || project || software || code || package))
+		((is || are))
+	))??
+	((
+		placed under
+	||
+		licensed under
+	||
+		released under
+	||
+		distributed under
+	||
+		available under
+	||
+		provided under
+	))
+	the same
+	((license || licence || terms || license terms || copyright terms))
+	as
+	((Vim || Vim itself))
+||
+	The
+	((Vim || Vim license || Vim licence))
+	applies to this
+	((file || plugin || script || project || software || code || package))
+))
+`
 const license_W3C_lre = `//**
 W3C Software Notice and License (2002-12-31)
 https://spdx.org/licenses/W3C.json
@@ -71009,6 +73553,7 @@ http://www.w3.org/Consortium/Legal/2002/copyright-software-20021231.html
 https://opensource.org/licenses/W3C
 **//
 
+
 (( W3C SOFTWARE NOTICE AND LICENSE ))??
 
 This work (and included software, documentation such as READMEs, or other
@@ -71066,6 +73611,24 @@ the same as the previous version and is written so as to preserve the Free
 Software Foundation's assessment of GPL compatibility and OSI's certification
 under the Open Source Definition. ))??
 `
+const license_W3C_Notice_lre = `//**
+W3C Software Notice and License, short notice
+http://www.w3.org/Consortium/Legal/2002/copyright-software-20021231.html
+**//
+
+
+
+This program is distributed under the W3C's Software Intellectual Property
+License.
+((
+	This program is distributed in the hope that it will be useful, but
+	WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+	or FITNESS FOR A PARTICULAR PURPOSE.
+))??
+((
+	See W3C License http://www.w3.org/Consortium/Legal/ for more details.
+))??
+`
 const license_W3C_19980720_lre = `//**
 W3C Software Notice and License (1998-07-20)
 https://spdx.org/licenses/W3C-19980720.json
@@ -71213,12 +73776,40 @@ TERMS AND CONDITIONS FOR COPYING, DISTRIBUTION AND MODIFICATION
    ((FUCK || F*** || F*CK))
    YOU WANT TO.
 `
+const license_WTFPL_Notice_lre = `//**
+Do What The F*ck You Want To Public License, short notice
+http://www.wtfpl.net/faq/
+**//
+
+
+
+
+((
+	((This program || This work || This file || This software || This library))
+	is free software. It comes without any warranty, to
+	the extent permitted by applicable law.
+||
+	((This program || This work || This file || This software || This library))
+	is free.
+))
+You can redistribute it and/or modify it under the
+terms of the Do What The
+((Fuck || F*** || F*ck))
+You Want To Public License, Version 2,
+as published by Sam Hocevar.
+((
+	See
+	((http://www.wtfpl.net/ || http://sam.zoy.org/wtfpl/ || the COPYING file || COPYING))
+	for more details.
+))??
+`
 const license_Watcom_1_0_lre = `//**
 Sybase Open Watcom Public License 1.0
 https://spdx.org/licenses/Watcom-1.0.json
 https://opensource.org/licenses/Watcom-1.0
 **//
 
+
 (( Sybase Open Watcom Public License version 1.0 ))??
 
 USE OF THE SYBASE OPEN WATCOM SOFTWARE DESCRIBED BELOW ("SOFTWARE") IS SUBJECT
@@ -71662,12 +74253,36 @@ subject to the following conditions:
 The above copyright notice and this permission notice shall be included in all
 copies or substantial portions of the Software.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE X CONSORTIUM
-BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF
-CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
-SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 
 Except as contained in this notice, the name of the X Consortium shall not be
 used in advertising or otherwise to promote the sale, use or other dealings in
@@ -71782,6 +74397,7 @@ https://spdx.org/licenses/Xnet.json
 https://opensource.org/licenses/Xnet
 **//
 
+
 (( The X.Net, Inc. License
 (( Copyright __20__ ))??
 ))??
@@ -71796,12 +74412,36 @@ subject to the following conditions:
 The above copyright notice and this permission notice shall be included in all
 copies or substantial portions of the Software.
 
-THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
-FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
-COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
-IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
-CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+((DISCLAIMER))??
+((2.))??
+
+THE
+((SOFTWARE || MATERIALS))
+IS PROVIDED "AS IS",
+WITHOUT WARRANTY OF ANY KIND,
+EXPRESS OR IMPLIED,
+INCLUDING BUT NOT LIMITED TO
+THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE
+AND NONINFRINGEMENT.
+IN NO EVENT
+((SHALL || WILL))
+__5__ BE LIABLE
+FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+WHETHER IN AN ACTION OF CONTRACT,
+((TORT || FART))
+OR OTHERWISE,
+ARISING FROM, OUT OF OR
+((IN || I))
+CONNECTION WITH
+((TE || THE))
+((SOFTWARE || MATERIALS))
+OR THE USE OR OTHER DEALINGS IN
+((THE
+	((SOFTWARE || MATERIALS))
+))??
+
 
 This agreement shall be governed in all respects by the laws of the State of
 California and by the laws of the United States of America.
@@ -72256,6 +74896,7 @@ http://old.zope.org/Resources/License/ZPL-2.0
 https://opensource.org/licenses/ZPL-2.0
 **//
 
+
 (( Zope Public License (ZPL) Version 2.0 ))??
 
 (( This software is Copyright (c) Zope Corporation (tm) and Contributors.
@@ -72308,12 +74949,30 @@ ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 individuals on behalf of Zope Corporation. Specific attributions are listed in
 the accompanying credits file. ))??
 `
+const license_ZPL_2_0_Notice_lre = `//**
+Zope Public License 2.0, short notice
+http://old.zope.org/Resources/License/ZPL-2.0
+**//
+
+
+
+This software is subject to the provisions of the Zope Public License,
+Version 2.0 (ZPL).
+((A copy of the ZPL should accompany this distribution.))??
+((
+	THIS SOFTWARE IS PROVIDED "AS IS" AND ANY AND ALL EXPRESS OR IMPLIED
+	WARRANTIES ARE DISCLAIMED, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+	WARRANTIES OF TITLE, MERCHANTABILITY, AGAINST INFRINGEMENT, AND FITNESS
+	FOR A PARTICULAR PURPOSE.
+))??
+`
 const license_ZPL_2_1_lre = `//**
 Zope Public License 2.1
 https://spdx.org/licenses/ZPL-2.1.json
 http://old.zope.org/Resources/ZPL/
 **//
 
+
 (( Zope Public License (ZPL) Version 2.1 ))??
 
 (( A copyright notice accompanies this license document that identifies the
@@ -72363,6 +75022,23 @@ CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING
 IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY
 OF SUCH DAMAGE.
 `
+const license_ZPL_2_1_Notice_lre = `//**
+Zope Public License 2.1, short notice
+https://github.com/zopefoundation/zope.interface/blob/master/src/zope/interface/__init__.py
+**//
+
+
+
+This software is subject to the provisions of the Zope Public License,
+Version 2.1 (ZPL).
+((A copy of the ZPL should accompany this distribution.))??
+((
+	THIS SOFTWARE IS PROVIDED "AS IS" AND ANY AND ALL EXPRESS OR IMPLIED
+	WARRANTIES ARE DISCLAIMED, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+	WARRANTIES OF TITLE, MERCHANTABILITY, AGAINST INFRINGEMENT, AND FITNESS
+	FOR A PARTICULAR PURPOSE.
+))??
+`
 const license_Zed_lre = `//**
 Zed License
 https://spdx.org/licenses/Zed.json
@@ -72811,6 +75487,7 @@ http://www.zlib.net/zlib_license.html
 https://opensource.org/licenses/Zlib
 **//
 
+
 (( zlib License
 (( Copyright __20__ ))??
 ))??
@@ -73449,6 +76126,26 @@ Except as contained in this notice, the name of a copyright holder shall not be
 used in advertising or otherwise to promote the sale, use or other dealings in
 this Software without prior written authorization of the copyright holder.
 `
+const license_curl_Notice_lre = `//**
+curl License, source file header
+https://github.com/curl/curl/blob/master/lib/easy.c
+**//
+
+
+
+This software is licensed as described in the file COPYING, which
+you should have received as part of this distribution. The terms
+are also available at __8__
+
+((
+	You may opt to use, copy, modify, merge, publish, distribute and/or sell
+	copies of the Software, and permit persons to whom the Software is
+	furnished to do so, under the terms of the COPYING file.
+))??
+
+This software is distributed on an "AS IS" basis, WITHOUT WARRANTY OF ANY
+KIND, either express or implied.
+`
 const license_diffmark_lre = `//**
 diffmark license
 https://spdx.org/licenses/diffmark.json
@@ -73592,6 +76289,7 @@ https://github.com/DISIC/politique-de-contribution-open-source/blob/master/LICEN
 https://raw.githubusercontent.com/DISIC/politique-de-contribution-open-source/master/LICENSE
 **//
 
+
 (( LICENCE OUVERTE / OPEN LICENCE
 
 - Version 2.0
@@ -0,0 +1,93 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"regexp"
+	"sort"
+	"unicode/utf8"
+)
+
+// A regexpDetector is a regular expression added by AddRegexp.
+type regexpDetector struct {
+	id  string
+	typ Type
+	re  *regexp.Regexp
+}
+
+// AddRegexp adds to s a detector for the license with the given ID and type,
+// which Scan reports wherever re matches, for a license that is easier
+// to describe with a Go regular expression than with an LRE.
+// The expression runs over the normalized form of the text, as returned
+// by ScanNormalized: the words of the text, in lower case and canonicalized,
+// separated by single spaces, without punctuation or markup.
+// Scan reports each non-empty match as a Match with the given ID and Type
+// whose Start and End are byte offsets in the scanned text,
+// from the start of the first word the match touches to the end of the last,
+// in order among the Scanner's other matches.
+//
+// A detector only adds matches: its matches never count toward the
+// Coverage's Percent, are never Complete, and do not stop the Scanner's
+// licenses from matching the same words. Classify ignores detectors.
+//
+// AddRegexp can be called while other goroutines are using s,
+// but Scans that are already running may not use the new detector.
+func (s *Scanner) AddRegexp(id string, t Type, re *regexp.Regexp) {
+	s.initBuiltin()
+	s.detectorsMu.Lock()
+	defer s.detectorsMu.Unlock()
+	s.detectors = append(s.detectors[:len(s.detectors):len(s.detectors)], regexpDetector{id, t, re})
+}
+
+// detect adds to c the matches in text of the detectors added by AddRegexp.
+func (s *Scanner) detect(c *Coverage, text []byte) {
+	s.detectorsMu.RLock()
+	detectors := s.detectors
+	s.detectorsMu.RUnlock()
+	if len(detectors) == 0 {
+		return
+	}
+
+	toks := s.Tokenize(text)
+	var norm []byte
+	lo := make([]int, len(toks)) // lo[i] is the offset of toks[i].Word in norm
+	hi := make([]int, len(toks)) // hi[i] is the offset of the end of toks[i].Word in norm
+	for i, t := range toks {
+		if i > 0 {
+			norm = append(norm, ' ')
+		}
+		lo[i] = len(norm)
+		norm = append(norm, t.Word...)
+		hi[i] = len(norm)
+	}
+
+	n := len(c.Match)
+	for _, d := range detectors {
+		for _, loc := range d.re.FindAllIndex(norm, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			// Words i through j-1 are those the match touches.
+			i := sort.Search(len(toks), func(i int) bool { return hi[i] > loc[0] })
+			j := sort.Search(len(toks), func(j int) bool { return lo[j] >= loc[1] })
+			if i >= j {
+				continue
+			}
+			start, end := toks[i].Start, toks[j-1].End
+			c.Match = append(c.Match, Match{
+				ID:        d.id,
+				Type:      d.typ,
+				Start:     start,
+				End:       end,
+				RuneStart: utf8.RuneCount(text[:start]),
+				RuneEnd:   utf8.RuneCount(text[:end]),
+				Words:     j - i,
+			})
+		}
+	}
+	if len(c.Match) > n {
+		sort.SliceStable(c.Match, func(i, j int) bool { return c.Match[i].Start < c.Match[j].Start })
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAddRegexp(t *testing.T) {
+	mit, err := ioutil.ReadFile("testdata/MIT.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mit = mit[bytes.Index(mit, []byte("\n\n"))+2:]
+	s := newTestScanner(t, []string{"MIT"})
+	text := append([]byte("// Internal-Use-Only License, v. 3 -- see LEGAL.txt.\n\n"), mit...)
+	before := s.Scan(text)
+
+	s.AddRegexp("Internal-3", Unknown, regexp.MustCompile(`internal use only license v 3`))
+	c := s.Scan(text)
+	if c.Percent != before.Percent {
+		t.Errorf("Percent = %.1f, want %.1f, unchanged by detector", c.Percent, before.Percent)
+	}
+	if len(c.Match) != 2 {
+		t.Fatalf("Scan found %d matches, want 2: %+v", len(c.Match), c.Match)
+	}
+	m := c.Match[0]
+	want := "Internal-Use-Only License, v. 3"
+	if m.ID != "Internal-3" || m.Complete || string(text[m.Start:m.End]) != want || m.Words != 6 {
+		t.Errorf("Match[0] = %s %q %d words, want Internal-3 %q 6 words", m.ID, text[m.Start:m.End], m.Words, want)
+	}
+	if m.RuneStart != m.Start || m.RuneEnd != m.End {
+		t.Errorf("Match[0] rune offsets = %d,%d, want %d,%d", m.RuneStart, m.RuneEnd, m.Start, m.End)
+	}
+	if c.Match[1].ID != "MIT" || !c.Match[1].Complete {
+		t.Errorf("Match[1] = %+v, want complete MIT", c.Match[1])
+	}
+
+	// A detector that matches no words reports nothing.
+	s.AddRegexp("Empty", Unknown, regexp.MustCompile(``))
+	if c := s.Scan(text); len(c.Match) != 2 {
+		t.Errorf("Scan with empty matches found %d matches, want 2", len(c.Match))
+	}
+
+	// The builtin Scanner is unaffected.
+	for _, m := range Scan(text).Match {
+		if strings.HasPrefix(m.ID, "Internal") {
+			t.Errorf("builtin Scan reported %s", m.ID)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"fmt"
+
+	"github.com/google/licensecheck/internal/match"
+)
+
+// A Dictionary holds a set of licenses with their license regular
+// expressions already parsed, for creating several Scanners that
+// recognize different subsets of the licenses, such as one Scanner
+// for each of several license policies. Each Scanner created from a
+// Dictionary by NewWithDictionary shares the Dictionary's word list and
+// parsed expressions instead of building its own, so a deployment using
+// many Scanners over overlapping license sets pays for each license once.
+//
+// A Dictionary is immutable once NewDictionary returns it: neither
+// NewWithDictionary nor the Scanners it creates modify it, so any number
+// of goroutines can use the same Dictionary and its Scanners at once.
+// The licenses are copied into the Dictionary, so changing the list
+// passed to NewDictionary afterward does not affect it.
+type Dictionary struct {
+	dict     *match.Dict
+	licenses []License        // licenses with valid LREs, or URLs alone, with includes expanded
+	lres     []*match.LRE     // lres[i] is the parsed LRE of licenses[i], or nil if it has none
+	errs     map[string]error // first error parsing an LRE of each license ID
+	includes func(id string) (string, bool)
+}
+
+// NewDictionary returns a new Dictionary holding the given licenses,
+// for use with NewWithDictionary.
+// NewDictionary parses every license's LRE, which is most of the work
+// of creating a Scanner. An LRE that cannot be parsed does not make
+// NewDictionary fail; instead, NewWithDictionary reports its error,
+// a *ParseError, when asked for a Scanner recognizing that license.
+func NewDictionary(licenses []License) *Dictionary {
+	d := &Dictionary{
+		dict:     new(match.Dict),
+		errs:     make(map[string]error),
+		includes: includeLookup(append([]License(nil), licenses...)),
+	}
+	d.dict.Insert("copyright")
+	d.dict.Insert("http")
+	for _, l := range licenses {
+		var re *match.LRE
+		if l.LRE != "" {
+			lre, err := match.ExpandIncludes(l.ID, l.LRE, d.includes)
+			if err == nil {
+				l.LRE = lre
+				re, err = match.ParseLRE(d.dict, l.ID, l.LRE)
+			}
+			if err != nil {
+				if d.errs[l.ID] == nil {
+					d.errs[l.ID] = parseError(l.ID, l.LRE, d.includes, err)
+				}
+				continue
+			}
+			if l.IsFragment {
+				re.SetFragment()
+			}
+		}
+		d.licenses = append(d.licenses, l)
+		d.lres = append(d.lres, re)
+	}
+	return d
+}
+
+// NewWithDictionary returns a new Scanner that recognizes the licenses
+// in d with the given IDs, including the notices, references, grants,
+// and URLs for those IDs, in the order they were passed to NewDictionary.
+// The Scanner uses the default options; in particular, Options that change
+// how words are read, like WithLooseNumbers and WithSpellingVariants,
+// cannot be used with a shared Dictionary.
+//
+// NewWithDictionary returns an error if an ID is not that of any license
+// in d, or if the LRE of a license with that ID could not be parsed.
+func NewWithDictionary(d *Dictionary, ids []string) (*Scanner, error) {
+	want := make(map[string]bool)
+	for _, id := range ids {
+		if err := d.errs[id]; err != nil {
+			return nil, err
+		}
+		want[id] = true
+	}
+	s := &Scanner{
+		urls:  make(map[string]License),
+		texts: make(map[string]string),
+		names: make(map[string]string),
+		osi:   make(map[string]bool),
+	}
+	found := make(map[string]bool)
+	var list []*match.LRE
+	for i, l := range d.licenses {
+		if !want[l.ID] {
+			continue
+		}
+		found[l.ID] = true
+		s.addInfo(l)
+		if re := d.lres[i]; re != nil {
+			s.licenses = append(s.licenses, l)
+			list = append(list, re)
+		}
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return nil, fmt.Errorf("NewWithDictionary: unknown license %s", id)
+		}
+	}
+	if err := s.compile(list, d.includes); err != nil {
+		return nil, err
+	}
+	s.lres = list
+	s.shared = true
+	return s, nil
+}
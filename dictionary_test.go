@@ -0,0 +1,65 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewWithDictionary(t *testing.T) {
+	d := NewDictionary(append(BuiltinLicenses(), License{ID: "Bad", LRE: "((missing close"}))
+	text := []byte(license_MIT + "\nSee https://www.apache.org/licenses/LICENSE-2.0 for details.\n")
+
+	s1, err := NewWithDictionary(d, []string{"MIT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := NewWithDictionary(d, []string{"MIT", "Apache-2.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s1.re.Dict() != s2.re.Dict() {
+		t.Errorf("Scanners do not share the Dictionary's word list")
+	}
+
+	for _, tt := range []struct {
+		s   *Scanner
+		ids []string
+	}{
+		{s1, []string{"MIT"}},
+		{s2, []string{"MIT", "Apache-2.0"}},
+	} {
+		have := tt.s.Scan(text)
+		want := newTestScanner(t, tt.ids).Scan(text)
+		have.text, want.text = nil, nil
+		if !reflect.DeepEqual(have, want) {
+			t.Errorf("Scan with %v:\nhave %+v\nwant %+v", tt.ids, have, want)
+		}
+	}
+
+	// MIT text departing from the license after its opening is a modified
+	// copy, not a fragment, for both kinds of Scanner.
+	i := strings.Index(license_MIT, "the above copyright notice")
+	modified := []byte(license_MIT[:i] + "The authors may change these terms at any time without notice,\n" +
+		"and nothing in this file obliges them to keep offering the software on these terms.\n")
+	have := s1.Scan(modified)
+	want := newTestScanner(t, []string{"MIT"}).Scan(modified)
+	have.text, want.text = nil, nil
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("Scan of modified MIT:\nhave %+v\nwant %+v", have, want)
+	}
+
+	if _, err := NewWithDictionary(d, []string{"MIT", "NoSuchLicense"}); err == nil {
+		t.Errorf("NewWithDictionary(unknown ID) succeeded, want error")
+	}
+	_, err = NewWithDictionary(d, []string{"Bad"})
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.LicenseID != "Bad" {
+		t.Errorf("NewWithDictionary(invalid LRE) = %v, want *ParseError for Bad", err)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/licensecheck/internal/match"
+)
+
+// A ParseError reports an invalid license regular expression
+// in a License passed to NewScanner, which returns it as its error
+// or passes it to the function set with WithOnError.
+//
+// Line and Col give the position of the error in the LRE, counting from 1,
+// with Col counted in runes. They are in the LRE of the license named
+// by Include, if set: that is, in an LRE that LicenseID pulls in with
+// an <<include>> directive. For an LRE that itself uses <<include>>,
+// positions of errors found after the includes are expanded are
+// in the expanded text. Line and Col are 0 for an error that is not
+// at a particular place, such as a pattern that cannot begin a match.
+//
+// The common mistakes have their own messages, such as
+// "missing )) at end" and "unexpected ))" for unbalanced groups,
+// "__5__ wildcard with no required text following" for a wildcard
+// ending the LRE or an optional group, "begins with wildcard phrase"
+// for a wildcard that would start a match, and
+// "opening //** without closing **//" for an unterminated comment.
+type ParseError struct {
+	LicenseID string // ID of the license whose LRE is invalid
+	Include   string // ID of the included LRE containing the error, if any
+	Line, Col int    // position of the error, or 0, 0 if unknown
+	Msg       string // description of the error
+	File      string // file containing the LRE, for a Scanner made by NewFromFS
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		fmt.Fprintf(&b, "%s: ", e.File)
+	}
+	fmt.Fprintf(&b, "parsing %s:", e.LicenseID)
+	if e.Include != "" {
+		fmt.Fprintf(&b, " %s:", e.Include)
+	}
+	if e.Line > 0 {
+		fmt.Fprintf(&b, "%d:%d:", e.Line, e.Col)
+	}
+	b.WriteString(" ")
+	b.WriteString(e.Msg)
+	return b.String()
+}
+
+// parseError returns a *ParseError for err, an error parsing
+// the license with the given ID, whose LRE is lre.
+// The includes function looks up included LREs, as for match.ExpandIncludes.
+func parseError(id, lre string, includes func(string) (string, bool), err error) *ParseError {
+	e := &ParseError{LicenseID: id, Msg: err.Error()}
+	var se *match.SyntaxError
+	if !errors.As(err, &se) {
+		return e
+	}
+	e.Msg = se.Err
+	if se.File != "" && se.File != id {
+		e.Include = se.File
+		lre, _ = includes(se.File)
+	}
+	if se.Offset >= 0 && se.Offset <= len(lre) {
+		e.Line, e.Col = lineCol(lre, se.Offset)
+	}
+	return e
+}
+
+// lineCol returns the 1-based line and rune column of byte offset off in s.
+func lineCol(s string, off int) (line, col int) {
+	start := strings.LastIndexByte(s[:off], '\n') + 1
+	return 1 + strings.Count(s[:off], "\n"), 1 + utf8.RuneCountInString(s[start:off])
+}
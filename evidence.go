@@ -0,0 +1,153 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/licensecheck/internal/match"
+)
+
+// maxEvidence is the maximum number of phrases that Evidence returns.
+const maxEvidence = 5
+
+// maxEvidenceIDs is the maximum number of license IDs whose LREs
+// can contain a pair of words for Evidence to count it as distinctive.
+const maxEvidenceIDs = 3
+
+// A wordPair is a pair of consecutive words.
+type wordPair [2]match.WordID
+
+// lrePairs returns the set of pairs of consecutive words in the LREs
+// of the licenses in s with the given ID. LRE syntax like (( and ||
+// reads as punctuation, so that alternatives run together;
+// the extra pairs that creates are harmless for Evidence.
+func (s *Scanner) lrePairs(id string) map[wordPair]bool {
+	var set map[wordPair]bool
+	for _, l := range s.licenses {
+		if l.ID != id {
+			continue
+		}
+		if set == nil {
+			set = make(map[wordPair]bool)
+		}
+		words := s.re.Dict().Split(l.LRE)
+		for i := 1; i < len(words); i++ {
+			if p := (wordPair{words[i-1].ID, words[i].ID}); p[0] >= 0 && p[1] >= 0 {
+				set[p] = true
+			}
+		}
+	}
+	return set
+}
+
+// Evidence returns the phrases in the match m, which must be one of the
+// matches that s.Scan(text) reports, that most distinguish its license
+// from the Scanner's other licenses: the places where the text uses
+// a pair of consecutive words that the license's regular expression
+// uses and the fewest other licenses do, like "danger that redistributors"
+// in GPL-2.0.
+// Each phrase is such a pair with the word before and after it,
+// or a run of overlapping pairs with the words around it, as written
+// in text but with its spacing, including line breaks, reduced to
+// single spaces.
+//
+// A pair of words is distinctive if it appears in the regular expressions
+// of the match's license and of at most two other licenses, so that
+// the licenses of a family, such as GPL-2.0 and its headers, count
+// as different licenses. Evidence returns at most five phrases,
+// the most distinctive first; it ignores words that the license's
+// regular expression does not spell out, such as the copyright holder's
+// name. It returns nil if the match has no distinctive phrases,
+// as for short notices made up of common legal phrases, or if m does not
+// lie within text or is a URL or SPDX tag match.
+//
+// The Scanner works out which pairs of words its licenses use the first
+// time Evidence is called, which takes some time for a large license set.
+func (s *Scanner) Evidence(text []byte, m Match) []string {
+	if m.IsURL || m.IsTag || m.Start < 0 || m.Start > m.End || m.End > len(text) {
+		return nil
+	}
+	s.initBuiltin()
+	s.evidenceOnce.Do(s.initEvidence)
+	own := s.lrePairs(m.ID)
+	if own == nil {
+		return nil
+	}
+
+	words := s.re.Dict().Split(string(text[m.Start:m.End]))
+	// ids returns the number of IDs using the pair of words ending at words[i],
+	// or 0 if the license does not use it.
+	ids := func(i int) int {
+		if p := (wordPair{words[i-1].ID, words[i].ID}); own[p] {
+			return s.evidence[p]
+		}
+		return 0
+	}
+	distinctive := func(i int) bool {
+		n := ids(i)
+		return n > 0 && n <= maxEvidenceIDs
+	}
+	type phrase struct {
+		lo, hi int // phrase is words[lo:hi]
+		ids    int // fewest IDs using any of its distinctive pairs
+	}
+	var list []phrase
+	for i := 1; i < len(words); i++ {
+		if !distinctive(i) {
+			continue
+		}
+		p := phrase{i - 1, i + 1, ids(i)}
+		for i+1 < len(words) && distinctive(i+1) {
+			i++
+			p.hi = i + 1
+			if n := ids(i); n < p.ids {
+				p.ids = n
+			}
+		}
+		if p.lo > 0 {
+			p.lo--
+		}
+		if p.hi < len(words) {
+			p.hi++
+		}
+		list = append(list, p)
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].ids < list[j].ids
+	})
+
+	var out []string
+	seen := make(map[string]bool)
+	for _, p := range list {
+		t := text[m.Start+int(words[p.lo].Lo) : m.Start+int(words[p.hi-1].Hi)]
+		f := strings.Join(strings.Fields(string(t)), " ")
+		if key := strings.ToLower(f); !seen[key] {
+			seen[key] = true
+			out = append(out, f)
+		}
+		if len(out) == maxEvidence {
+			break
+		}
+	}
+	return out
+}
+
+// initEvidence initializes s.evidence.
+func (s *Scanner) initEvidence() {
+	ids := make(map[wordPair]int)
+	done := make(map[string]bool)
+	for _, l := range s.licenses {
+		if done[l.ID] {
+			continue
+		}
+		done[l.ID] = true
+		for p := range s.lrePairs(l.ID) {
+			ids[p]++
+		}
+	}
+	s.evidence = ids
+}
@@ -0,0 +1,50 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestEvidence(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/GPL-2.0.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := data[bytes.Index(data, []byte("\n\n"))+2:]
+	cov := Scan(text)
+	if len(cov.Match) != 1 {
+		t.Fatalf("Scan(GPL-2.0): %d matches, want 1", len(cov.Match))
+	}
+	ev := builtinScanner.Evidence(text, cov.Match[0])
+	if len(ev) == 0 || len(ev) > maxEvidence {
+		t.Fatalf("Evidence(GPL-2.0) = %q, want 1 to %d phrases", ev, maxEvidence)
+	}
+	flat := strings.Join(strings.Fields(string(text)), " ")
+	for _, p := range ev {
+		if !strings.Contains(flat, p) {
+			t.Errorf("Evidence(GPL-2.0) phrase %q not in text", p)
+		}
+	}
+
+	// Among MIT and Apache-2.0 alone, MIT has phrases of its own.
+	s := newTestScanner(t, []string{"MIT", "Apache-2.0"})
+	cov = s.Scan([]byte(license_MIT))
+	if len(cov.Match) != 1 {
+		t.Fatalf("Scan(MIT): %d matches, want 1", len(cov.Match))
+	}
+	if ev := s.Evidence([]byte(license_MIT), cov.Match[0]); len(ev) == 0 {
+		t.Errorf("Evidence(MIT) = %q, want phrases", ev)
+	} else if strings.Contains(ev[0], "right gopher") {
+		t.Errorf("Evidence(MIT) = %q, includes copyright holder", ev)
+	}
+
+	if ev := s.Evidence([]byte(license_MIT), Match{ID: "MIT", Start: 0, End: len(license_MIT) + 1}); ev != nil {
+		t.Errorf("Evidence(out of range) = %q, want nil", ev)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"regexp"
+	"unicode/utf8"
+)
+
+// CleanExtracted returns a copy of data, which should be text extracted
+// from a word-processor document such as an RTF or Word file, with common
+// extraction artifacts removed, so that Scan can match the license text
+// the artifacts interrupt. CleanExtracted removes:
+//
+//   - RTF control words, such as \par, \tab, and \f0\fs24,
+//     and ignorable destinations, such as {\*\fldinst ...};
+//   - field instructions, such as HYPERLINK "https://example.com/";
+//   - the braces of RTF groups and fields; and
+//   - page headers and footers: short lines, such as "Page 3 of 9",
+//     that repeat at least three times, ignoring any numbers in them.
+//
+// RTF escapes for special characters, such as \'93 and \~,
+// are replaced by the characters they stand for, and form feeds by newlines.
+//
+// CleanExtracted is lossy: it cannot tell artifacts from text that merely
+// looks like them, so it may remove text that was really part of the
+// document, such as a backslash-prefixed word or a repeated short line.
+// It is therefore meant only for preparing extracted text for Scan,
+// and the offsets in the resulting Coverage are relative to the cleaned text.
+// CleanExtracted leaves the text unchanged in other respects.
+func CleanExtracted(data []byte) []byte {
+	data = removeRTFDestinations(data)
+	data = fieldInstRE.ReplaceAll(data, nil)
+	data = rtfControlRE.ReplaceAllFunc(data, rtfControl)
+	data = bytes.Map(func(r rune) rune {
+		switch r {
+		case '{', '}':
+			return -1
+		case '\f':
+			return '\n'
+		}
+		return r
+	}, data)
+	return removeRepeatedLines(data)
+}
+
+var (
+	// rtfControlRE matches an RTF control word with its optional
+	// numeric parameter and delimiting space, or an RTF control symbol.
+	rtfControlRE = regexp.MustCompile(`\\(?:[a-z]{1,32}(?:-?[0-9]{1,10})? ?|'[0-9a-fA-F]{2}|[~_\-\\{}*])`)
+
+	// fieldInstRE matches a field instruction left in plain text,
+	// like HYPERLINK "https://example.com/" \l "top".
+	fieldInstRE = regexp.MustCompile(`\b(?:HYPERLINK|INCLUDETEXT|PAGEREF|REF) +"[^"\n]*"(?: +\\[a-z*](?: +"[^"\n]*")?)*`)
+
+	numberRE = regexp.MustCompile(`[0-9]+`)
+)
+
+// removeRTFDestinations returns a copy of data with each ignorable
+// RTF destination group, such as {\*\fldinst ...}, removed,
+// including any groups nested inside it.
+// An unterminated group is left alone.
+func removeRTFDestinations(data []byte) []byte {
+	var out []byte
+	for {
+		i := bytes.Index(data, []byte(`{\*\`))
+		if i < 0 {
+			break
+		}
+		out = append(out, data[:i]...)
+		depth := 0
+		j := i
+	Group:
+		for ; j < len(data); j++ {
+			switch data[j] {
+			case '\\':
+				j++ // skip escaped character
+			case '{':
+				depth++
+			case '}':
+				if depth--; depth == 0 {
+					j++
+					break Group
+				}
+			}
+		}
+		if depth > 0 {
+			// Unterminated group: keep it.
+			out = append(out, data[i:]...)
+			return out
+		}
+		data = data[j:]
+	}
+	return append(out, data...)
+}
+
+// rtfControl returns the replacement for the RTF control word or symbol c.
+func rtfControl(c []byte) []byte {
+	switch c[1] {
+	case '\'':
+		return []byte(string(cp1252Rune(unhex(c[2])<<4 | unhex(c[3]))))
+	case '~':
+		return []byte(" ")
+	case '_':
+		return []byte("-")
+	case '-', '*':
+		return nil
+	case '\\', '{', '}':
+		return c[1:]
+	}
+	switch string(bytes.TrimSuffix(c, []byte(" "))) {
+	case `\par`, `\line`, `\sect`, `\page`:
+		return []byte("\n")
+	case `\tab`:
+		return []byte("\t")
+	}
+	return nil
+}
+
+// unhex returns the value of the hexadecimal digit c.
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	}
+	return c - 'A' + 10
+}
+
+// cp1252Rune returns the rune for the Windows-1252 byte b,
+// as used by RTF \'hh escapes.
+func cp1252Rune(b byte) rune {
+	if r, ok := cp1252[b]; ok {
+		return r
+	}
+	if b < 0x80 || b >= 0xA0 {
+		return rune(b) // ASCII and Latin-1
+	}
+	return utf8.RuneError
+}
+
+// cp1252 maps the Windows-1252 punctuation bytes to their runes.
+var cp1252 = map[byte]rune{
+	0x85: '…',
+	0x91: '‘',
+	0x92: '’',
+	0x93: '“',
+	0x94: '”',
+	0x95: '•',
+	0x96: '–',
+	0x97: '—',
+	0x99: '™',
+}
+
+// maxRepeatedLine is the maximum length of a line
+// that removeRepeatedLines treats as a page header or footer.
+const maxRepeatedLine = 80
+
+// removeRepeatedLines returns data with any short line that appears
+// at least three times, ignoring spacing and numbers, removed.
+// Lines without letters, such as separators or bare numbers, are kept.
+func removeRepeatedLines(data []byte) []byte {
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	key := func(line []byte) string {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || len(line) > maxRepeatedLine || !bytes.ContainsAny(line, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ") {
+			return ""
+		}
+		return string(numberRE.ReplaceAll(line, []byte("#")))
+	}
+	count := make(map[string]int)
+	for _, line := range lines {
+		if k := key(line); k != "" {
+			count[k]++
+		}
+	}
+	var out []byte
+	for _, line := range lines {
+		if k := key(line); k != "" && count[k] >= 3 {
+			continue
+		}
+		out = append(out, line...)
+	}
+	return out
+}
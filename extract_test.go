@@ -0,0 +1,52 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+var cleanExtractedTests = []struct {
+	in  string
+	out string
+}{
+	{"no artifacts here\n", "no artifacts here\n"},
+	{`first\par second\tab third`, "first\nsecond\tthird"},
+	{`{\b bold}\b0  text`, "bold text"},
+	{`{\rtf1\ansi\f0\fs24 text}`, "text"},
+	{`\'93quoted\'94 it\'92s caf\'e9`, "“quoted” it’s café"},
+	{`non\~breaking soft\-hyphen \{brace\}`, "non breaking softhyphen brace"},
+	{`see {\field{\*\fldinst{HYPERLINK "https://example.com/"}}{\fldrslt https://example.com/}}.`, "see https://example.com/."},
+	{`see HYPERLINK "https://example.com/" \l "top" https://example.com/`, "see  https://example.com/"},
+	{`a {\*\comment {nested} group} b`, "a  b"},
+	{`a {\*\unterminated b`, "a b"},
+	{"page one\fpage two", "page one\npage two"},
+	{"Page 1 of 3\nalpha\nPage 2 of 3\nbeta\nPage 3 of 3\ngamma\n", "alpha\nbeta\ngamma\n"},
+	{"Page 1 of 2\nalpha\nPage 2 of 2\n", "Page 1 of 2\nalpha\nPage 2 of 2\n"},
+	{"*\nalpha\n*\nbeta\n*\n", "*\nalpha\n*\nbeta\n*\n"},
+}
+
+func TestCleanExtracted(t *testing.T) {
+	for _, tt := range cleanExtractedTests {
+		if out := string(CleanExtracted([]byte(tt.in))); out != tt.out {
+			t.Errorf("CleanExtracted(%q) = %q, want %q", tt.in, out, tt.out)
+		}
+	}
+
+	data, err := ioutil.ReadFile("testdata/Apache-2.0-RTF.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := data[bytes.Index(data, []byte("\n\n"))+2:]
+	if cov := Scan(text); cov.Percent > 10 {
+		t.Errorf("Scan(RTF text) = %.1f%%, want < 10%%", cov.Percent)
+	}
+	cov := Scan(CleanExtracted(text))
+	if len(cov.Match) != 1 || cov.Match[0].ID != "Apache-2.0" || !cov.Match[0].Complete || cov.Percent < 99 {
+		t.Errorf("Scan(CleanExtracted(RTF text)) = %.1f%% %v, want 100%% complete Apache-2.0", cov.Percent, cov.Match)
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// NewFromFS returns a new Scanner that recognizes the licenses
+// described by the .lre files in the file tree rooted at root in fsys,
+// written like the package's own licenses/*.lre files (see licenses/README.md).
+// The options, if any, adjust the Scanner's behavior, as for NewScanner.
+//
+// Each file is a text/template, as in the licenses directory:
+// a file can use the templates defined in any other, by {{define}} or
+// by its file name, and the functions {{Type "X"}}, {{Notice "ID"}},
+// {{Reference "ID"}}, {{Grant "ID"}}, {{OSIApproved}}, {{Fragment}},
+// and {{Lang "tag"}} set the corresponding License fields. A file whose output is empty,
+// because it holds only definitions, describes no license.
+// The license ID is the file name without .lre, unless set by
+// {{Notice}}, {{Reference}}, or {{Grant}}. The //** **// comment that
+// begins a license text's file, if it has a name on its first line and
+// URLs on the following ones, gives the license's full name and
+// identifying URLs, except that an SPDX JSON URL is ignored.
+// The licenses are ordered by ID and then by file name, so that
+// a notice sorts after the license text it refers to
+// and, when a text matches more than one license, the earlier one is reported.
+//
+// NewFromFS returns an error for a file it cannot read or execute as a template,
+// naming the file. An invalid LRE is reported as a *ParseError, as by NewScanner,
+// with File set to the path of its file in fsys.
+func NewFromFS(fsys fs.FS, root string, opts ...Option) (*Scanner, error) {
+	licenses, files, err := readLREs(fsys, root)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Scanner)
+	for _, opt := range opts {
+		opt(&s.opts)
+	}
+	if err := s.init(licenses, files); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// readLREs returns the licenses described by the .lre files
+// under root in fsys, as described in NewFromFS,
+// along with the path of the file describing each one.
+// Like gen_data.go, which does the same for the builtin licenses,
+// it executes the files as a single set of templates.
+func readLREs(fsys fs.FS, root string) ([]License, []string, error) {
+	var paths []string
+	err := fs.WalkDir(fsys, root, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(file, ".lre") {
+			paths = append(paths, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var l License
+	t := template.New("").Funcs(template.FuncMap{
+		"list": templateList,
+		"Type": func(s string) (string, error) {
+			typ, err := ParseType(s)
+			l.Type = typ
+			return "", err
+		},
+		"Notice":      func(id string) string { l.ID, l.IsNotice = id, true; return "" },
+		"Reference":   func(id string) string { l.ID, l.IsReference = id, true; return "" },
+		"Grant":       func(id string) string { l.ID, l.IsGrant = id, true; return "" },
+		"OSIApproved": func() string { l.OSIApproved = true; return "" },
+		"Fragment":    func() string { l.IsFragment = true; return "" },
+		"Lang":        func(tag string) string { l.Lang = tag; return "" },
+	})
+	// A file can define templates named like files, such as
+	// {{define "BSD-3-Clause.lre"}} in licenses/BSD.lre,
+	// each of which describes a license too.
+	defined := make(map[string]string) // file defining each .lre template
+	var names []string
+	for _, file := range paths {
+		name := path.Base(file)
+		if prev, ok := defined[name]; ok {
+			return nil, nil, fmt.Errorf("%s: %s already defined in %s", file, name, prev)
+		}
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := t.New(name).Parse(string(data)); err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", file, err)
+		}
+		for _, tt := range t.Templates() {
+			if n := tt.Name(); strings.HasSuffix(n, ".lre") && defined[n] == "" {
+				defined[n] = file
+				names = append(names, n)
+			}
+		}
+	}
+
+	type entry struct {
+		name, file string
+		l          License
+	}
+	var list []entry
+	for _, name := range names {
+		file := defined[name]
+		var buf bytes.Buffer
+		l = License{ID: strings.TrimSuffix(name, ".lre")}
+		if err := t.ExecuteTemplate(&buf, name, nil); err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", file, err)
+		}
+		if len(bytes.TrimSpace(buf.Bytes())) == 0 {
+			// Only contained useful definitions.
+			continue
+		}
+		l.LRE = buf.String()
+		if !l.IsNotice && !l.IsReference && !l.IsGrant {
+			licenseName, urls := lreHeader(l.LRE)
+			if licenseName != l.ID {
+				l.Name = licenseName
+			}
+			for _, u := range urls {
+				list = append(list, entry{name, file, License{ID: l.ID, Type: l.Type, URL: u}})
+			}
+		}
+		list = append(list, entry{name, file, l})
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].l.ID != list[j].l.ID {
+			return list[i].l.ID < list[j].l.ID
+		}
+		return strings.TrimSuffix(list[i].name, ".lre") < strings.TrimSuffix(list[j].name, ".lre")
+	})
+
+	licenses := make([]License, len(list))
+	files := make([]string, len(list))
+	for i, e := range list {
+		licenses[i], files[i] = e.l, e.file
+	}
+	return licenses, files, nil
+}
+
+// lreHeader returns the license name and identifying URLs from the
+// header comment that begins an LRE file, as written by getspdx:
+//
+//	//**
+//	Apache License 2.0
+//	https://spdx.org/licenses/Apache-2.0.json
+//	http://www.apache.org/licenses/LICENSE-2.0
+//	...
+//	**//
+//
+// The URLs are in the form used by Scanner.licenseURL, without their scheme
+// or trailing slash, in lower case, and the SPDX JSON URL is omitted.
+// As in gen_data.go, only a name followed by a URL is recognized.
+// If there is no such name, lreHeader returns "", nil.
+func lreHeader(lre string) (name string, urls []string) {
+	text := strings.TrimSpace(lre)
+	if !strings.HasPrefix(text, "//**") {
+		return "", nil
+	}
+	text = strings.TrimPrefix(text, "//**")
+	if i := strings.Index(text, "**//"); i >= 0 {
+		text = text[:i]
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) < 3 || strings.TrimSpace(lines[0]) != "" || !isHTTP(lines[2]) {
+		return "", nil
+	}
+	for _, line := range lines[2:] {
+		line = strings.TrimSpace(line)
+		if !isHTTP(line) {
+			break
+		}
+		u := strings.TrimPrefix(strings.TrimPrefix(line, "http://"), "https://")
+		u = strings.ToLower(strings.TrimSuffix(u, "/"))
+		if strings.HasPrefix(u, "spdx.org/licenses/") && strings.HasSuffix(u, ".json") {
+			continue
+		}
+		urls = append(urls, u)
+	}
+	return strings.TrimSpace(lines[1]), urls
+}
+
+// isHTTP reports whether s begins with http:// or https://.
+func isHTTP(s string) bool {
+	return strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://")
+}
+
+// templateList returns xs, but it flattens any nested []interface{} into the main list.
+// Called from templates as "list", to pass multiple arguments to templates.
+func templateList(xs ...interface{}) []interface{} {
+	var list []interface{}
+	for _, x := range xs {
+		switch x := x.(type) {
+		case []interface{}:
+			list = append(list, x...)
+		default:
+			list = append(list, x)
+		}
+	}
+	return list
+}
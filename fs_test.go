@@ -0,0 +1,133 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestNewFromFSBuiltin(t *testing.T) {
+	s, err := NewFromFS(os.DirFS("licenses"), ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type key struct{ id, lre string }
+	loaded := make(map[key]License)
+	for _, l := range s.licenses {
+		loaded[key{l.ID, l.LRE}] = l
+	}
+	for _, want := range builtinLREs {
+		have, ok := loaded[key{want.ID, want.LRE}]
+		if !ok {
+			t.Errorf("NewFromFS: missing %s LRE", want.ID)
+			continue
+		}
+		if have != want {
+			t.Errorf("NewFromFS: %s = %+v, want %+v", want.ID, have, want)
+		}
+	}
+	if len(s.licenses) != len(builtinLREs) {
+		t.Errorf("NewFromFS: %d licenses, want %d", len(s.licenses), len(builtinLREs))
+	}
+
+	data, err := os.ReadFile("testdata/Apache-2.0.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cov := s.Scan(data)
+	if len(cov.Match) != 1 || cov.Match[0].ID != "Apache-2.0" {
+		t.Errorf("Scan(Apache-2.0.t1) = %+v, want one Apache-2.0 match", cov.Match)
+	}
+}
+
+var testFS = fstest.MapFS{
+	"corpus/defs.lre": {Data: []byte(`{{define "grant"}}Permission to use this program is granted to everyone.{{end}}`)},
+	"corpus/My-1.0.lre": {Data: []byte(`//**
+My License 1.0
+https://spdx.org/licenses/My-1.0.json
+https://example.com/licenses/my-1.0/
+**//
+{{Type "Notice"}}
+
+The My License 1.0.
+
+{{template "grant"}}
+The program is provided as is, without warranty of any kind.
+`)},
+	"corpus/notices/My-1.0-Notice.lre": {Data: []byte(`{{Notice "My-1.0"}}
+This program is licensed under the My License, version 1.0.
+`)},
+	"corpus/README.md": {Data: []byte("not an LRE")},
+}
+
+func TestNewFromFS(t *testing.T) {
+	s, err := NewFromFS(testFS, "corpus")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, l := range s.licenses {
+		ids = append(ids, l.ID)
+		if !l.IsNotice && l.Type != Notice {
+			t.Errorf("NewFromFS: %s Type = %v, want Notice", l.ID, l.Type)
+		}
+	}
+	if have, want := strings.Join(ids, " "), "My-1.0 My-1.0"; have != want {
+		t.Errorf("NewFromFS: licenses %s, want %s", have, want)
+	}
+	if !s.licenses[1].IsNotice {
+		t.Errorf("NewFromFS: second license is not the notice")
+	}
+	if have, want := s.LicenseName("My-1.0"), "My License 1.0"; have != want {
+		t.Errorf("LicenseName = %q, want %q", have, want)
+	}
+
+	text := "The My License 1.0.\n\nPermission to use this program is granted to everyone.\n" +
+		"The program is provided as is, without warranty of any kind.\n"
+	cov := s.Scan([]byte(text))
+	if cov.Percent != 100 || len(cov.Match) != 1 || cov.Match[0].ID != "My-1.0" || cov.Match[0].Type != Notice {
+		t.Errorf("Scan(text) = %+v, want My-1.0 at 100%%", cov)
+	}
+
+	cov = s.Scan([]byte("This program is licensed under the My License, version 1.0.\n"))
+	if len(cov.Match) != 1 || cov.Match[0].ID != "My-1.0" || !cov.Match[0].IsNotice {
+		t.Errorf("Scan(notice) = %+v, want My-1.0 notice", cov.Match)
+	}
+
+	cov = s.Scan([]byte("See https://example.com/licenses/my-1.0 for details.\n"))
+	if len(cov.Match) != 1 || cov.Match[0].ID != "My-1.0" || !cov.Match[0].IsURL {
+		t.Errorf("Scan(URL) = %+v, want My-1.0 URL", cov.Match)
+	}
+}
+
+func TestNewFromFSErrors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"Bad.lre": {Data: []byte("a b c\n((\n\td e\n")},
+	}
+	_, err := NewFromFS(fsys, ".")
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.File != "Bad.lre" || pe.LicenseID != "Bad" || pe.Line != 4 {
+		t.Errorf("NewFromFS(Bad.lre) error = %#v, want *ParseError in Bad.lre at line 4", err)
+	}
+	if want := "Bad.lre: parsing Bad:4:1: missing )) at end"; err == nil || err.Error() != want {
+		t.Errorf("NewFromFS(Bad.lre) error = %v, want %q", err, want)
+	}
+
+	for _, fsys := range []fstest.MapFS{
+		{"x/Syntax.lre": {Data: []byte("a b {{Notice}")}},
+		{"x/Undefined.lre": {Data: []byte(`a b {{template "nope"}}`)}},
+		{"x/Type.lre": {Data: []byte(`{{Type "Nope"}} a b`)}},
+		{"x/a/Dup.lre": {Data: []byte("a b")}, "x/b/Dup.lre": {Data: []byte("c d")}},
+	} {
+		_, err := NewFromFS(fsys, "x")
+		if err == nil || !strings.HasPrefix(err.Error(), "x/") {
+			t.Errorf("NewFromFS(%v) error = %v, want error naming file", fsys, err)
+		}
+	}
+}
@@ -45,7 +45,11 @@ func main() {
 	out := new(bytes.Buffer)
 	builtLRE := buildLRE(filesLRE)
 	for _, file := range builtLRE {
-		fmt.Fprintf(out, "\t\t{ID: %q, %s LRE: %v},\n", file.Name, file.Type, varName(file.Name+".lre"))
+		name := ""
+		if file.LicenseName != "" {
+			name = fmt.Sprintf("Name: %q,", file.LicenseName)
+		}
+		fmt.Fprintf(out, "\t\t{ID: %q, %s %s LRE: %v},\n", file.ID, name, file.Type, varName(file.Name+".lre"))
 	}
 	code = strings.Replace(code, "FILES_LIST", out.String(), -1)
 
@@ -98,9 +102,11 @@ var builtinLREs = []License{
 `
 
 type fileData struct {
-	Name string
-	Type string
-	Data []byte
+	Name        string // file name, without .lre
+	ID          string // license ID
+	LicenseName string // full license name, from the header comment
+	Type        string // extra License fields, like `Type: Notice,`
+	Data        []byte
 }
 
 func buildLRE(filesLRE []string) []fileData {
@@ -113,10 +119,58 @@ func buildLRE(filesLRE []string) []fileData {
 		typ = t
 		return "", nil
 	}
+	// {{Notice "ID"}} marks the file as matching a short notice
+	// referring to the license ID, instead of the license text.
+	var noticeID string
+	setNotice := func(id string) string {
+		noticeID = id
+		return ""
+	}
+	// {{Reference "ID"}} marks the file as matching a brief reference
+	// to the license ID by name, like "licensed under GPLv2".
+	var referenceID string
+	setReference := func(id string) string {
+		referenceID = id
+		return ""
+	}
+	// {{Grant "ID"}} marks the file as matching a prose statement
+	// granting the license ID by name, like "released under the MIT license".
+	var grantID string
+	setGrant := func(id string) string {
+		grantID = id
+		return ""
+	}
+	// {{OSIApproved}} marks the license as approved by the
+	// Open Source Initiative, as recorded by SPDX (see getspdx.go).
+	var osiApproved bool
+	setOSIApproved := func() string {
+		osiApproved = true
+		return ""
+	}
+	// {{Fragment}} marks a notice as matching only the opening
+	// of the license text, such as its first sentence quoted alone.
+	var fragment bool
+	setFragment := func() string {
+		fragment = true
+		return ""
+	}
+	// {{Lang "tag"}} records the language of a license text
+	// not written in English, as a BCP 47 tag like "de" or "fr".
+	var lang string
+	setLang := func(tag string) string {
+		lang = tag
+		return ""
+	}
 	var out []fileData
 	t := template.New("").Funcs(template.FuncMap{
-		"list": templateList,
-		"Type": setType,
+		"list":        templateList,
+		"Type":        setType,
+		"Notice":      setNotice,
+		"Reference":   setReference,
+		"Grant":       setGrant,
+		"OSIApproved": setOSIApproved,
+		"Fragment":    setFragment,
+		"Lang":        setLang,
 	})
 	t, err := t.ParseFiles(filesLRE...)
 	if err != nil {
@@ -126,6 +180,12 @@ func buildLRE(filesLRE []string) []fileData {
 		if strings.HasSuffix(t.Name(), ".lre") {
 			var buf bytes.Buffer
 			typ = licensecheck.Unknown
+			noticeID = ""
+			referenceID = ""
+			grantID = ""
+			osiApproved = false
+			fragment = false
+			lang = ""
 			if err := t.Execute(&buf, nil); err != nil {
 				log.Fatalf("executing %s: %v", t.Name(), err)
 			}
@@ -137,11 +197,45 @@ func buildLRE(filesLRE []string) []fileData {
 			if typ != licensecheck.Unknown {
 				tstr = "Type: " + typ.String() + ","
 			}
-			out = append(out, fileData{strings.TrimSuffix(t.Name(), ".lre"), tstr, buf.Bytes()})
+			if osiApproved {
+				tstr += " OSIApproved: true,"
+			}
+			name := strings.TrimSuffix(t.Name(), ".lre")
+			id := name
+			if noticeID != "" {
+				id = noticeID
+				tstr += " IsNotice: true,"
+			}
+			if fragment {
+				tstr += " IsFragment: true,"
+			}
+			if referenceID != "" {
+				id = referenceID
+				tstr += " IsReference: true,"
+			}
+			if grantID != "" {
+				id = grantID
+				tstr += " IsGrant: true,"
+			}
+			if lang != "" {
+				tstr += fmt.Sprintf(" Lang: %q,", lang)
+			}
+			licenseName := headerName(buf.Bytes())
+			if licenseName == id || noticeID != "" || referenceID != "" || grantID != "" {
+				// A bare ID is no more friendly than the ID itself,
+				// and the header of a notice describes the notice, not the license.
+				licenseName = ""
+			}
+			out = append(out, fileData{name, id, licenseName, tstr, buf.Bytes()})
 		}
 	}
 	sort.Slice(out, func(i, j int) bool {
-		ni, nj := out[i].Name, out[j].Name
+		ni, nj := out[i].ID, out[j].ID
+		if ni == nj {
+			// A notice, reference, or grant (see {{Notice}}, {{Reference}}, {{Grant}})
+			// sorts after the license text it refers to.
+			ni, nj = out[i].Name, out[j].Name
+		}
 
 		// Special case: BSD-4-Clause is a generalization of BSD-4-Clause-UC.
 		// In case of multiple matches, licensecheck always returns the one earlier in the list.
@@ -162,6 +256,31 @@ func buildLRE(filesLRE []string) []fileData {
 	return out
 }
 
+// headerName returns the license name from the header comment
+// that begins an LRE file, as written by getspdx:
+//
+//	//**
+//	Apache License 2.0
+//	https://spdx.org/licenses/Apache-2.0.json
+//	...
+//	**//
+//
+// Only a name followed by a URL is recognized, since hand-written
+// headers often begin with a note instead. If there is no such name,
+// headerName returns "".
+func headerName(data []byte) string {
+	text := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(text, "//**") {
+		return ""
+	}
+	lines := strings.SplitN(strings.TrimPrefix(text, "//**"), "\n", 4)
+	if len(lines) < 3 || strings.TrimSpace(lines[0]) != "" ||
+		!strings.HasPrefix(lines[2], "https://") && !strings.HasPrefix(lines[2], "http://") {
+		return ""
+	}
+	return strings.TrimSpace(lines[1])
+}
+
 // templateList returns xs, but it flattens any nested []interface{} into the main list.
 // Called from templates as "list", to pass multiple arguments to templates.
 func templateList(xs ...interface{}) []interface{} {
@@ -0,0 +1,104 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// WriteGoSource writes to w a Go source file for package pkg
+// declaring a variable named varName that holds c.Match, as in:
+//
+//	// Code generated by licensecheck; DO NOT EDIT.
+//
+//	package pkg
+//
+//	import "github.com/google/licensecheck"
+//
+//	// varName lists the licenses found by licensecheck, in text order.
+//	var varName = []licensecheck.Match{
+//		{ID: "MIT", Type: licensecheck.Notice, Start: 0, End: 1077, ...},
+//	}
+//
+// so that a go:generate step can record the licenses found in a build's
+// inputs in the program it builds. Each Match lists only its exported fields
+// that are set, and its Type is written using the package's Type constants.
+// If c has no matches, the variable is declared as a nil slice.
+// The file is formatted as by gofmt.
+//
+// WriteGoSource returns an error if pkg or varName is not a Go identifier
+// or is a Go keyword.
+func (c Coverage) WriteGoSource(w io.Writer, pkg, varName string) error {
+	for _, name := range []string{pkg, varName} {
+		if !token.IsIdentifier(name) {
+			return fmt.Errorf("WriteGoSource: invalid identifier %q", name)
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by licensecheck; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/google/licensecheck\"\n\n")
+	fmt.Fprintf(&b, "// %s lists the licenses found by licensecheck, in text order.\n", varName)
+	if len(c.Match) == 0 {
+		fmt.Fprintf(&b, "var %s []licensecheck.Match\n", varName)
+	} else {
+		fmt.Fprintf(&b, "var %s = []licensecheck.Match{\n", varName)
+		for _, m := range c.Match {
+			fmt.Fprintf(&b, "{%s},\n", goMatchFields(m))
+		}
+		fmt.Fprintf(&b, "}\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("WriteGoSource: %v", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// goMatchFields returns the keyed fields of a Go composite literal for m,
+// listing the exported fields that are not zero, in declaration order.
+func goMatchFields(m Match) string {
+	var fields []string
+	v := reflect.ValueOf(m)
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.PkgPath != "" || v.Field(i).IsZero() {
+			continue
+		}
+		var val string
+		if t, ok := v.Field(i).Interface().(Type); ok {
+			val = goType(t)
+		} else {
+			val = fmt.Sprintf("%#v", v.Field(i).Interface())
+		}
+		fields = append(fields, f.Name+": "+val)
+	}
+	return strings.Join(fields, ", ")
+}
+
+// goType returns a Go expression for t using the package's Type constants,
+// like "licensecheck.Notice | licensecheck.ShareChanges".
+func goType(t Type) string {
+	var list []string
+	for _, b := range typeBits {
+		if b.t != 0 && t&b.t == b.t {
+			t &^= b.t
+			list = append(list, "licensecheck."+b.s)
+		}
+	}
+	if t != 0 {
+		list = append(list, fmt.Sprintf("licensecheck.Type(%#x)", uint(t)))
+	}
+	return strings.Join(list, " | ")
+}
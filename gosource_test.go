@@ -0,0 +1,70 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+)
+
+var goSourceTests = []struct {
+	c    Coverage
+	want string
+}{
+	{
+		Coverage{},
+		`// Code generated by licensecheck; DO NOT EDIT.
+
+package licenses
+
+import "github.com/google/licensecheck"
+
+// Found lists the licenses found by licensecheck, in text order.
+var Found []licensecheck.Match
+`,
+	},
+	{
+		Coverage{Percent: 100, Match: []Match{
+			{ID: "MIT", Type: Notice, Start: 0, End: 1077, RuneEnd: 1077, Words: 161, Complete: true, CopyrightYears: []int{2019, 2020}, wordEnd: 161},
+			{ID: "GPL-2.0", Type: ShareChanges | ShareProgram | Type(1<<20), Start: 1080, End: 1100, RuneStart: 1080, RuneEnd: 1100, IsURL: true},
+		}},
+		`// Code generated by licensecheck; DO NOT EDIT.
+
+package licenses
+
+import "github.com/google/licensecheck"
+
+// Found lists the licenses found by licensecheck, in text order.
+var Found = []licensecheck.Match{
+	{ID: "MIT", Type: licensecheck.Notice, End: 1077, RuneEnd: 1077, Words: 161, Complete: true, CopyrightYears: []int{2019, 2020}},
+	{ID: "GPL-2.0", Type: licensecheck.ShareChanges | licensecheck.ShareProgram | licensecheck.Type(0x100000), Start: 1080, End: 1100, RuneStart: 1080, RuneEnd: 1100, IsURL: true},
+}
+`,
+	},
+}
+
+func TestWriteGoSource(t *testing.T) {
+	for _, tt := range goSourceTests {
+		var b bytes.Buffer
+		if err := tt.c.WriteGoSource(&b, "licenses", "Found"); err != nil {
+			t.Fatal(err)
+		}
+		if have := b.String(); have != tt.want {
+			t.Errorf("WriteGoSource:\nhave:\n%s\nwant:\n%s", have, tt.want)
+		}
+		if src, err := format.Source(b.Bytes()); err != nil || !bytes.Equal(src, b.Bytes()) {
+			t.Errorf("WriteGoSource output is not gofmt-clean (err=%v)", err)
+		}
+	}
+
+	for _, name := range []string{"", "func", "1x", "a.b"} {
+		err := Coverage{}.WriteGoSource(new(bytes.Buffer), "licenses", name)
+		if err == nil || !strings.Contains(err.Error(), "invalid identifier") {
+			t.Errorf("WriteGoSource(varName %q) = %v, want invalid identifier error", name, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// MaxGzipSize is the largest decompressed text that ScanGzip reads,
+// in bytes. It is far larger than any license file, but small enough
+// that a few bytes of gzip data decompressing to gigabytes,
+// a "gzip bomb", cannot exhaust memory.
+const MaxGzipSize = 64 << 20
+
+// maxGzipSize is MaxGzipSize, as a variable that tests can lower.
+var maxGzipSize int64 = MaxGzipSize
+
+// ScanGzip decompresses the gzip-compressed data read from r
+// and scans the result using s, or the built-in license set if s is nil.
+// The offsets in the returned Coverage are relative to the decompressed text.
+// If r does not contain valid gzip data, or if the data decompresses
+// to more than MaxGzipSize bytes, ScanGzip returns an error.
+func ScanGzip(s *Scanner, r io.Reader) (Coverage, error) {
+	if s == nil {
+		s = builtinScanner
+	}
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return Coverage{}, fmt.Errorf("ScanGzip: %w", err)
+	}
+	text, err := io.ReadAll(io.LimitReader(zr, maxGzipSize+1))
+	if err != nil {
+		return Coverage{}, fmt.Errorf("ScanGzip: %w", err)
+	}
+	if int64(len(text)) > maxGzipSize {
+		return Coverage{}, fmt.Errorf("ScanGzip: decompressed data larger than %d bytes", maxGzipSize)
+	}
+	if err := zr.Close(); err != nil {
+		return Coverage{}, fmt.Errorf("ScanGzip: %w", err)
+	}
+	return s.Scan(text), nil
+}
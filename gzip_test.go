@@ -0,0 +1,55 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestScanGzip(t *testing.T) {
+	text := "Preamble.\n" + license_MIT
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(text))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := newTestScanner(t, []string{"MIT"})
+	cov, err := ScanGzip(s, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := s.Scan([]byte(text))
+	if len(cov.Match) != 1 || !reflect.DeepEqual(cov.Match[0], want.Match[0]) || cov.Percent != want.Percent {
+		t.Errorf("ScanGzip = %+v, want %+v", cov, want)
+	}
+
+	_, err = ScanGzip(s, strings.NewReader(license_MIT))
+	if !errors.Is(err, gzip.ErrHeader) {
+		t.Errorf("ScanGzip(plain text) error = %v, want %v", err, gzip.ErrHeader)
+	}
+
+	_, err = ScanGzip(s, bytes.NewReader(buf.Bytes()[:buf.Len()/2]))
+	if err == nil {
+		t.Errorf("ScanGzip(truncated) succeeded, want error")
+	}
+
+	// Data decompressing to more than the limit is rejected.
+	defer func(old int64) { maxGzipSize = old }(maxGzipSize)
+	maxGzipSize = int64(len(text))
+	if _, err := ScanGzip(s, bytes.NewReader(buf.Bytes())); err != nil {
+		t.Errorf("ScanGzip at the size limit: %v", err)
+	}
+	maxGzipSize = int64(len(text)) - 1
+	if _, err := ScanGzip(s, bytes.NewReader(buf.Bytes())); err == nil || !strings.Contains(err.Error(), "larger than") {
+		t.Errorf("ScanGzip over the size limit = %v, want error", err)
+	}
+}
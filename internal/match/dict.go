@@ -19,8 +19,33 @@ import (
 // Insert is a write operation; it must not run concurrently with
 // any other call, whether to Insert, Lookup, or Words.
 type Dict struct {
-	dict map[string]WordID // dict maps word to index in list
-	list []string          // list of known words
+	dict  map[string]WordID     // dict maps word to index in list
+	list  []string              // list of known words
+	exact map[WordID]*exactWord // punctuation-sensitive words, written [[word]] in LREs
+
+	foldNumbers  bool // read all numbers as numberWord
+	foldSpelling bool // read British spellings in spellingVariants as American
+}
+
+// numberWord is the word that all numbers are read as
+// in a Dict with FoldNumbers set.
+// No text splits into it otherwise.
+const numberWord = "#"
+
+// FoldNumbers makes d read every number (a word made of ASCII digits)
+// as the same word, so that any number matches any other.
+// It must be called before d is used.
+func (d *Dict) FoldNumbers() {
+	d.foldNumbers = true
+}
+
+// FoldSpelling makes d read the British spellings in its closed list
+// of spelling variants, such as "licence" and "acknowledgement",
+// as their American counterparts, "license" and "acknowledgment",
+// so that either spelling matches the other.
+// It must be called before d is used.
+func (d *Dict) FoldSpelling() {
+	d.foldSpelling = true
 }
 
 // A WordID is the index of a word in a dictionary.
@@ -61,6 +86,18 @@ func (d *Dict) Lookup(w string) WordID {
 	return id
 }
 
+// Truncate removes from d the words inserted since it held n words,
+// so that a caller can undo the insertions made by parsing an LRE
+// that then turned out to be unusable. No LRE still in use
+// may refer to the removed words.
+func (d *Dict) Truncate(n int) {
+	for id := n; id < len(d.list); id++ {
+		delete(d.dict, d.list[id])
+		delete(d.exact, WordID(id))
+	}
+	d.list = d.list[:n]
+}
+
 // Words returns the current word list.
 // The list is not a copy; the caller can read but must not modify the list.
 func (d *Dict) Words() []string {
@@ -80,6 +117,18 @@ func (d *Dict) InsertSplit(text string) []Word {
 	return d.split(text, true)
 }
 
+// insertSplitFollowed is like InsertSplit, but text is followed by follow
+// in some larger text, which may affect how the last few words of text
+// are read. The words in follow are not split or inserted.
+func (d *Dict) insertSplitFollowed(text, follow string) []Word {
+	var words []Word
+	d.splitFunc(text, follow, true, func(w Word, _ []byte) bool {
+		words = append(words, w)
+		return true
+	})
+	return words
+}
+
 // Split splits text into a sequence of lowercase words.
 // It does not add any new words to the dictionary.
 // Unrecognized words are reported as having ID = BadWord.
@@ -91,8 +140,26 @@ func (d *Dict) Split(text string) []Word {
 var copyright = []byte("copyright")
 
 func (d *Dict) split(text string, insert bool) []Word {
-	var wbuf []byte
 	var words []Word
+	d.splitFunc(text, "", insert, func(w Word, _ []byte) bool {
+		words = append(words, w)
+		return true
+	})
+	return words
+}
+
+// SplitFunc is like Split but calls yield with each word in turn,
+// along with the lowercase, canonicalized form of the word,
+// instead of returning the words in a slice.
+// The form is only valid during the call; yield must copy it to retain it.
+// If yield returns false, SplitFunc stops splitting and returns.
+func (d *Dict) SplitFunc(text string, yield func(w Word, form []byte) bool) {
+	d.splitFunc(text, "", false, yield)
+}
+
+func (d *Dict) splitFunc(text, follow string, insert bool, yield func(Word, []byte) bool) {
+	var wbuf []byte
+	prev := BadWord
 	t := text
 	for t != "" {
 		var w []byte
@@ -140,18 +207,25 @@ func (d *Dict) split(text string, insert bool) []Word {
 			// Scan whole word
 			// (except © which is already a word by itself,
 			// even when it appears next to other text,
-			// like ©1996).
+			// like ©1996, and a Chinese or Japanese character,
+			// which is a word by itself since those languages
+			// are written without spaces between words).
 			lo = int32(len(text) - len(t))
-			if r != '©' {
+			if r != '©' && !isIdeograph(r) {
 				for size < len(t) {
 					r, s := utf8.DecodeRuneInString(t[size:])
-					if !isWordContinue(r) {
+					if !isWordContinue(r) || isIdeograph(r) {
 						break
 					}
 					size += s
 					wbuf = appendFoldRune(wbuf, r)
 				}
-				if size+3 <= len(t) && t[size:size+3] == "(s)" {
+				if n := acronymVersionSize(t[:size]); n > 0 {
+					// Read "GPLv2" as "GPL v2", so that compact version
+					// shorthands match however the LRE or text spells them.
+					size = n
+					wbuf = wbuf[:n]
+				} else if size+3 <= len(t) && (t[size:size+3] == "(s)" || t[size:size+3] == "(S)") {
 					// Read "notice(s)" as "notices" and let spell-check accept "notice" too.
 					wbuf = append(wbuf, 's')
 					size += 3
@@ -162,6 +236,19 @@ func (d *Dict) split(text string, insert bool) []Word {
 
 			w = wbuf
 
+			if string(w) == "section" || string(w) == "article" {
+				// Drop the "Section" in a heading like "Section 1. Definitions",
+				// so that it reads like "1. Definitions", "1) Definitions",
+				// or "(1) Definitions": punctuation is already ignored.
+				rest := t
+				if follow != "" {
+					rest = t + follow
+				}
+				if headingNumberSize(rest) > 0 {
+					continue
+				}
+			}
+
 			// Special case rewrites suggested by SPDX.
 			switch {
 			case string(w) == "https":
@@ -173,7 +260,9 @@ func (d *Dict) split(text string, insert bool) []Word {
 				lo--
 				hi++
 
-			case string(w) == "©":
+			case string(w) == "©", string(w) == "copr":
+				// "Copr." is the abbreviation of "Copyright"
+				// allowed in U.S. copyright notices.
 				w = copyright
 			}
 
@@ -183,29 +272,36 @@ func (d *Dict) split(text string, insert bool) []Word {
 					w = append(w[:0], m.x...)
 				}
 			}
+
+			if d.foldSpelling {
+				if us, ok := spellingVariants[string(w)]; ok {
+					w = append(w[:0], us...)
+				}
+			}
+
+			if d.foldNumbers && isNumber(w) {
+				w = append(w[:0], numberWord...)
+			}
 		}
 
 	Emit:
 		id, ok := d.dict[string(w)]
 		if ok {
-			if len(words) > 0 && words[len(words)-1].ID == id && string(w) == "copyright" {
+			if prev == id && string(w) == "copyright" {
 				// Treat "Copyright ©" as a single "copyright" instead of two.
 				continue
 			}
-			words = append(words, Word{id, lo, hi})
-			continue
+		} else if insert {
+			id = d.Insert(string(w))
+		} else {
+			// Unknown word
+			id = BadWord
 		}
-
-		if insert {
-			words = append(words, Word{d.Insert(string(w)), lo, hi})
-			continue
+		prev = id
+		if !yield(Word{id, lo, hi}, w) {
+			return
 		}
-
-		// Unknown word
-		words = append(words, Word{BadWord, lo, hi})
 	}
-
-	return words
 }
 
 // foldRune returns the folded rune r.
@@ -223,15 +319,26 @@ func (d *Dict) split(text string, insert bool) []Word {
 // but that will require more thought about exactly what to do
 // and doing it efficiently. For now, the accents are enough.
 func foldRune(r rune) rune {
-	// Iterate SimpleFold until we hit the min equivalent rune,
-	// which - for the ones we care about - is the upper case ASCII rune.
-	for {
-		r1 := unicode.SimpleFold(r)
-		if r1 >= r {
-			break
+	if r < utf8.RuneSelf {
+		if 'A' <= r && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+		if r == '(' || r == ')' {
+			// delete ( ) in (c) or notice(s)
+			return -1
+		}
+		return r
+	}
+
+	// Iterate SimpleFold around the orbit to find the min equivalent rune,
+	// which - for the ones we care about - is the upper case rune.
+	min := r
+	for r1 := unicode.SimpleFold(r); r1 != r; r1 = unicode.SimpleFold(r1) {
+		if r1 < min {
+			min = r1
 		}
-		r = r1
 	}
+	r = min
 
 	switch r {
 	case 'Á', 'À':
@@ -246,15 +353,12 @@ func foldRune(r rune) rune {
 		return 'u'
 	}
 
-	if 'A' <= r && r <= 'Z' {
-		r += 'a' - 'A'
-	}
-	if r == '(' || r == ')' {
-		// delete ( ) in (c) or notice(s)
-		return -1
+	if r < utf8.RuneSelf {
+		// The orbit of a non-ASCII rune, like the Kelvin sign,
+		// can include an ASCII letter.
+		return r + 'a' - 'A'
 	}
-
-	return r
+	return unicode.ToLower(r)
 }
 
 // toFold converts s to folded form.
@@ -296,6 +400,15 @@ func isWordContinue(r rune) bool {
 	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
 }
 
+// isIdeograph reports whether r is a Chinese character or Japanese kana,
+// which the Dict reads as a word by itself. Text in those scripts has
+// no spaces between words, so reading each run of characters between
+// punctuation marks as a word would make a match depend on where
+// the text happens to be broken into lines.
+func isIdeograph(r rune) bool {
+	return r >= 0x3000 && unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
 // htmlTagSize returns the length of the HTML tag at the start of t, or else 0.
 func htmlTagSize(t string) int {
 	if len(t) < 3 || t[0] != '<' {
@@ -431,6 +544,97 @@ func markdownLinkSize(t string) int {
 	return 0
 }
 
+// isNumber reports whether w is made up entirely of ASCII digits.
+func isNumber(w []byte) bool {
+	for _, c := range w {
+		if c < '0' || '9' < c {
+			return false
+		}
+	}
+	return len(w) > 0
+}
+
+// headingNumberSize returns the size of the section number at the start of t,
+// which follows a word like "Section", if it numbers a heading:
+// a number like "1" or "3.2", punctuation like "." or " –",
+// and then a letter, as in " 1. Definitions".
+// Spacing, markup, comment markers, and LRE operators between them are skipped.
+// If t does not start that way, headingNumberSize returns 0.
+// A reference to a section, as in " 3 above" or " 4(d)", is not a heading.
+// The case of the letter is ignored, so that a license written entirely
+// in capitals reads the same as the original. As a result, a reference
+// followed by punctuation, as in " 2) in object code", reads like a heading,
+// but it does so in the LREs and the input alike.
+func headingNumberSize(t string) int {
+	i := skipGap(t, 0, true)
+	if i == 0 || i >= len(t) || t[i] < '0' || '9' < t[i] {
+		return 0
+	}
+	for i < len(t) && ('0' <= t[i] && t[i] <= '9' || t[i] == '.' && i+1 < len(t) && '0' <= t[i+1] && t[i+1] <= '9') {
+		i++
+	}
+	n := i
+	i = skipGap(t, i, false)
+	switch {
+	case i < len(t) && strings.IndexByte(".):-", t[i]) >= 0:
+		i++
+	case strings.HasPrefix(t[i:], "–"), strings.HasPrefix(t[i:], "—"):
+		i += len("–")
+	default:
+		return 0
+	}
+	j := skipGap(t, i, true)
+	if j == i || j >= len(t) || !('A' <= t[j] && t[j] <= 'Z' || 'a' <= t[j] && t[j] <= 'z') {
+		return 0
+	}
+	return n
+}
+
+// skipGap returns the offset in t of the end of the spacing and markup
+// starting at offset i, which includes any ASCII punctuation if punct is true.
+func skipGap(t string, i int, punct bool) int {
+	for i < len(t) {
+		c := t[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '<' && htmlTagSize(t[i:]) > 0:
+			i += htmlTagSize(t[i:])
+		case c == '&' && htmlEntitySize(t[i:]) > 0:
+			i += htmlEntitySize(t[i:])
+		case punct && c < utf8.RuneSelf && !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'):
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// acronymVersionSize returns the size of the acronym at the start of a word
+// like "GPLv2" or "LGPLv21", made up of two or more upper-case ASCII letters
+// followed by a v, in either case, and a version number.
+// If w does not have that form, acronymVersionSize returns 0.
+func acronymVersionSize(w string) int {
+	i := 0
+	for i < len(w) && 'A' <= w[i] && w[i] <= 'Z' {
+		i++
+	}
+	if i > 2 && i < len(w) && w[i-1] == 'V' {
+		// All capitals, as in "GPLV2".
+		i--
+	}
+	if i < 2 || i+1 >= len(w) || w[i] != 'v' && w[i] != 'V' {
+		return 0
+	}
+	for j := i + 1; j < len(w); j++ {
+		if w[j] < '0' || '9' < w[j] {
+			return 0
+		}
+	}
+	return i
+}
+
 // canonicalRewrites is a list of pairs that are canonicalized during word splittting.
 // The words on the right are parsed as if they were the words on the left.
 // This happens during dictionary splitting, so canMisspell will never see any
@@ -446,3 +650,41 @@ var canonicalRewrites = []struct {
 	{"the", "those"},
 	{"copy", "copies"}, // most plurals are handled as 1-letter typos
 }
+
+// spellingVariants maps British spellings to the American spellings
+// that a Dict with FoldSpelling set reads them as.
+// The list is deliberately closed and limited to words that appear
+// in license texts and have no other meaning, so that folding never
+// makes unrelated words match. Many of the pairs are already within
+// spell checking's one-letter tolerance, but listing them lets them
+// match exactly, including in the start phrases where spell checking
+// does not apply.
+var spellingVariants = map[string]string{
+	"acknowledgement":  "acknowledgment",
+	"acknowledgements": "acknowledgments",
+	"authorisation":    "authorization",
+	"authorise":        "authorize",
+	"authorised":       "authorized",
+	"behaviour":        "behavior",
+	"centre":           "center",
+	"defence":          "defense",
+	"favour":           "favor",
+	"fulfil":           "fulfill",
+	"judgement":        "judgment",
+	"licence":          "license",
+	"licenced":         "licensed",
+	"licences":         "licenses",
+	"licencing":        "licensing",
+	"licencor":         "licensor",
+	"licencors":        "licensors",
+	"modelling":        "modeling",
+	"offence":          "offense",
+	"organisation":     "organization",
+	"organisations":    "organizations",
+	"practise":         "practice",
+	"programme":        "program",
+	"programmes":       "programs",
+	"recognise":        "recognize",
+	"recognised":       "recognized",
+	"utilise":          "utilize",
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -148,6 +149,8 @@ var insertSplitTests = []struct {
 	{"(C) 2020 Gopher®", "copyright 2020 gopher"},
 	{"© 2020 Gopher®", "copyright 2020 gopher"},
 	{"&copy; 2020 Gopher®", "copyright 2020 gopher"},
+	{"Copr. 2020 Gopher®", "copyright 2020 gopher"},
+	{"Copr. © 2020 Gopher®", "copyright 2020 gopher"},
 	{"a b c (c) d", "a b c copyright d"},
 	{"a b c copyright d", "a b c copyright d"},
 	{"a b c © d", "a b c copyright d"},
@@ -155,6 +158,21 @@ var insertSplitTests = []struct {
 	{"http://golang.org", "http golang org"},
 	{"https://golang.org", "http golang org"},
 	{"the notice(s) must", "the notices must"},
+
+	// Any run of Unicode spacing separates words, as in justified text.
+	{"abc  def\t\tghi\n\n\njkl", "abc def ghi jkl"},
+	{"abc\u00a0\u00a0def\u2003ghi\u3000jkl\u2028mno", "abc def ghi jkl mno"},
+
+	// Chinese and Japanese characters are words by themselves,
+	// so line breaks between them do not change the words.
+	{"木兰宽松许可证，第2版", "木 兰 宽 松 许 可 证 第 2 版"},
+	{"木兰宽松\n许可证", "木 兰 宽 松 许 可 证"},
+	{"Mulan宽松PSL", "mulan 宽 松 psl"},
+	{"ライセンス", "ラ イ セ ン ス"},
+
+	// Compact version shorthands split like their spaced forms.
+	{"GPLv2 GPL v2 LGPLv2.1 AGPLv3", "gpl v2 gpl v2 lgpl v2 1 agpl v3"},
+	{"Gplv2 GPLv GPLv2a gplv2 Xv2", "gplv2 gplv gplv2a gplv2 xv2"},
 }
 
 func TestDictInsertSplit(t *testing.T) {
@@ -210,6 +228,105 @@ func TestDictSplit(t *testing.T) {
 	}
 }
 
+func TestDictFoldNumbers(t *testing.T) {
+	var d Dict
+	d.FoldNumbers()
+	words := d.InsertSplit("Section 3.1, version 2 (v2) of 2020 and 1a")
+	var out []string
+	for _, w := range words {
+		out = append(out, d.Words()[w.ID])
+	}
+	want := "section # # version # v2 of # and 1a"
+	if have := strings.Join(out, " "); have != want {
+		t.Errorf("InsertSplit with FoldNumbers = %q, want %q", have, want)
+	}
+}
+
+func TestDictTruncate(t *testing.T) {
+	var d Dict
+	d.InsertSplit("the quick brown fox")
+	n := len(d.Words())
+	if _, err := reParse(&d, "the lazy [[dog's]] fox", false); err != nil {
+		t.Fatal(err)
+	}
+	d.Truncate(n)
+	if have := strings.Join(d.Words(), " "); have != "the quick brown fox" {
+		t.Errorf("Words after Truncate = %q, want %q", have, "the quick brown fox")
+	}
+	if id := d.Lookup("lazy"); id != BadWord {
+		t.Errorf("Lookup(lazy) after Truncate = %d, want BadWord", id)
+	}
+	if len(d.exact) != 0 {
+		t.Errorf("Truncate left %d punctuation-sensitive words", len(d.exact))
+	}
+	if id := d.Insert("jumps"); int(id) != n {
+		t.Errorf("Insert(jumps) after Truncate = %d, want %d", id, n)
+	}
+}
+
+func TestDictSectionHeading(t *testing.T) {
+	var d Dict
+	for _, text := range []string{
+		"1. Definitions. 2) Grant",
+		"(1) Definitions. (2) Grant",
+		"Section 1. Definitions. SECTION 2) Grant",
+		"Article 1 – Definitions. Article 2: Grant",
+		"SECTION 1. DEFINITIONS. SECTION 2: GRANT",
+		"section 1. definitions. section 2) grant",
+	} {
+		words := d.InsertSplit(text)
+		var out []string
+		for _, w := range words {
+			out = append(out, d.Words()[w.ID])
+		}
+		want := "1 definitions 2 grant"
+		if have := strings.Join(out, " "); have != want {
+			t.Errorf("InsertSplit(%q) = %q, want %q", text, have, want)
+		}
+	}
+
+	// References to sections keep the word.
+	words := d.InsertSplit("Section 3 above, Section 4(d). and section 5.")
+	var out []string
+	for _, w := range words {
+		out = append(out, d.Words()[w.ID])
+	}
+	want := "section 3 above section 4 d and section 5"
+	if have := strings.Join(out, " "); have != want {
+		t.Errorf("InsertSplit with section references = %q, want %q", have, want)
+	}
+}
+
+func TestDictUpperCase(t *testing.T) {
+	var d Dict
+	split := func(text string) string {
+		var out []string
+		for _, w := range d.InsertSplit(text) {
+			out = append(out, d.Words()[w.ID])
+		}
+		return strings.Join(out, " ")
+	}
+	text := "Licensed under GPLv2 or LGPLv21; see the Notice(s) of Ångström, Σ and Kelvin."
+	want := split(text)
+	if have := split(strings.ToUpper(text)); have != want {
+		t.Errorf("InsertSplit(upper case) = %q, want %q", have, want)
+	}
+}
+
+func TestDictFoldSpelling(t *testing.T) {
+	var d Dict
+	d.FoldSpelling()
+	words := d.InsertSplit("Licenced under the Licence; see Acknowledgements. Licencee colour")
+	var out []string
+	for _, w := range words {
+		out = append(out, d.Words()[w.ID])
+	}
+	want := "licensed under the license see acknowledgments licencee colour"
+	if have := strings.Join(out, " "); have != want {
+		t.Errorf("InsertSplit with FoldSpelling = %q, want %q", have, want)
+	}
+}
+
 var mitLicenseRot13 = ` // MIT License, rot13 to hide from license scanners
 pbclevtug 2020 gur evtug tbcure
 
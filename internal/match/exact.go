@@ -0,0 +1,98 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Punctuation-sensitive words
+
+package match
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// An exactWord is a punctuation-sensitive word written [[word]] in an LRE.
+// It matches the same words of a text that the plain word does,
+// but only where the text spells them with the same punctuation.
+type exactWord struct {
+	text  string   // the word as written in the LRE
+	pre   int      // length of the punctuation in text before the first word
+	words []WordID // the words that text splits into
+}
+
+// insertExact adds the punctuation-sensitive word s to the dictionary,
+// returning the index of the new word. The word list records it as "[[s]]",
+// in lower case, which no text splits into.
+func (d *Dict) insertExact(s string) (WordID, error) {
+	if s == "" || strings.IndexFunc(s, unicode.IsSpace) >= 0 {
+		return BadWord, errors.New("[[ ]] must enclose a single word without spaces")
+	}
+	words := d.InsertSplit(s)
+	if len(words) == 0 {
+		return BadWord, errors.New("[[" + s + "]] contains no words")
+	}
+	x := &exactWord{text: s, pre: int(words[0].Lo)}
+	for _, w := range words {
+		x.words = append(x.words, w.ID)
+	}
+	id := d.Insert("[[" + strings.ToLower(s) + "]]")
+	if d.exact == nil {
+		d.exact = make(map[WordID]*exactWord)
+	}
+	d.exact[id] = x
+	return id, nil
+}
+
+// textPhrase returns the phrase of text words with which
+// a match beginning with the phrase p of LRE words can begin,
+// replacing punctuation-sensitive words by the words they match.
+func (d *Dict) textPhrase(p phrase) phrase {
+	if x := d.exact[p[0]]; x != nil {
+		if len(x.words) > 1 {
+			return phrase{x.words[0], x.words[1]}
+		}
+		p[0] = x.words[0]
+	}
+	if x := d.exact[p[1]]; x != nil {
+		p[1] = x.words[0]
+	}
+	return p
+}
+
+// matchExact reports the number of words at the start of words,
+// which were split from text, that the punctuation-sensitive word w matches,
+// or 0 if w is not a punctuation-sensitive word or does not match there.
+// The text must spell the words exactly as w does, ignoring case,
+// and it must not continue with more letters or digits
+// before the next space, so that [[2.0]] does not match 2.0.1.
+func (d *Dict) matchExact(w WordID, text string, words []Word) int {
+	x := d.exact[w]
+	if x == nil || len(words) < len(x.words) {
+		return 0
+	}
+	for i, id := range x.words {
+		if words[i].ID != id {
+			return 0
+		}
+	}
+	lo := int(words[0].Lo) - x.pre
+	hi := lo + len(x.text)
+	if lo < 0 || hi > len(text) || !strings.EqualFold(text[lo:hi], x.text) {
+		return 0
+	}
+	for _, r := range text[hi:] {
+		if unicode.IsSpace(r) {
+			break
+		}
+		if isWordContinue(r) {
+			return 0
+		}
+	}
+	return len(x.words)
+}
+
+// isExact reports whether w is a punctuation-sensitive word.
+func (d *Dict) isExact(w WordID) bool {
+	return d.exact[w] != nil
+}
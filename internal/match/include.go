@@ -0,0 +1,84 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package match
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// includeTag is the prefix of an include directive.
+const includeTag = "<<include"
+
+// ExpandIncludes returns the LRE s with each include directive
+//
+//	<<include id>>
+//
+// replaced by the LRE that lookup returns for id, itself expanded.
+// A directive must be on a line by itself, possibly indented.
+// The file name is the name of s, as passed to ParseLRE;
+// s cannot include itself, directly or indirectly.
+//
+// If a directive is malformed, names an id for which lookup reports false,
+// or would lead back to an LRE already being included,
+// ExpandIncludes returns a *SyntaxError. For an error in an included LRE,
+// the SyntaxError's File is the id of that LRE and its Offset is in that LRE.
+func ExpandIncludes(file, s string, lookup func(id string) (lre string, ok bool)) (string, error) {
+	return expandIncludes(s, lookup, []string{file})
+}
+
+// expandIncludes implements ExpandIncludes.
+// The stack holds the names of the LREs being expanded, outermost first.
+func expandIncludes(s string, lookup func(string) (string, bool), stack []string) (string, error) {
+	if !strings.Contains(s, includeTag) {
+		return s, nil
+	}
+
+	var b strings.Builder
+	i := 0
+	for {
+		j := strings.Index(s[i:], includeTag)
+		if j < 0 {
+			break
+		}
+		j += i
+		k := strings.Index(s[j:], ">>")
+		if k < 0 {
+			return "", reSyntaxError(s, j, errors.New("<<include without closing >>"))
+		}
+		k += j + len(">>")
+		if !atBOL(s, j) || !atEOL(s, k) {
+			return "", reSyntaxError(s, j, errors.New("<<include not on a line by itself"))
+		}
+		arg := s[j+len(includeTag) : k-len(">>")]
+		f := strings.Fields(arg)
+		if len(f) != 1 || arg[0] != ' ' && arg[0] != '\t' {
+			return "", reSyntaxError(s, j, fmt.Errorf("malformed %s", s[j:k]))
+		}
+		id := f[0]
+		for n, x := range stack {
+			if x == id {
+				return "", reSyntaxError(s, j, fmt.Errorf("include cycle: %s -> %s", strings.Join(stack[n:], " -> "), id))
+			}
+		}
+		sub, ok := lookup(id)
+		if !ok {
+			return "", reSyntaxError(s, j, fmt.Errorf("undefined include %s", id))
+		}
+		sub, err := expandIncludes(sub, lookup, append(stack[:len(stack):len(stack)], id))
+		if err != nil {
+			if e, ok := err.(*SyntaxError); ok && e.File == "" {
+				e.File = id
+			}
+			return "", err
+		}
+		b.WriteString(s[i:j])
+		b.WriteString(sub)
+		i = k
+	}
+	b.WriteString(s[i:])
+	return b.String(), nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package match
+
+import (
+	"strings"
+	"testing"
+)
+
+var includeLREs = map[string]string{
+	"header": "Copyright Example Corp.\nAll rights reserved.",
+	"nested": "Preamble.\n<<include header>>\n",
+	"loop1":  "a\n<<include loop2>>\n",
+	"loop2":  "b\n<<include loop1>>\n",
+	"self":   "<<include self>>\n",
+	"broken": "x\n<<include missing>>\n",
+}
+
+var expandIncludesTests = []struct {
+	in  string
+	out string
+	err string
+}{
+	{in: "no includes", out: "no includes"},
+	{in: "<<include header>>\nmore", out: "Copyright Example Corp.\nAll rights reserved.\nmore"},
+	{in: "((\n\t<<include header>>\n))??", out: "((\n\tCopyright Example Corp.\nAll rights reserved.\n))??"},
+	{in: "first\n<<include nested>>\nlast", out: "first\nPreamble.\nCopyright Example Corp.\nAll rights reserved.\n\nlast"},
+	{in: "<<include header>>\n<<include header>>", out: "Copyright Example Corp.\nAll rights reserved.\nCopyright Example Corp.\nAll rights reserved."},
+	{in: "a <<include header>>", err: "<<include not on a line by itself"},
+	{in: "<<include header>> b", err: "<<include not on a line by itself"},
+	{in: "<<include header", err: "<<include without closing >>"},
+	{in: "<<include>>", err: "malformed <<include>>"},
+	{in: "<<includeheader>>", err: "malformed <<includeheader>>"},
+	{in: "<<include a b>>", err: "malformed <<include a b>>"},
+	{in: "<<include missing>>", err: "undefined include missing"},
+	{in: "<<include broken>>", err: "broken:#2: syntax error near `x\n`: undefined include missing"},
+	{in: "<<include top>>", err: "include cycle: top -> top"},
+	{in: "<<include self>>", err: "self:#0: syntax error: include cycle: self -> self"},
+	{in: "<<include loop1>>", err: "include cycle: loop1 -> loop2 -> loop1"},
+}
+
+func TestExpandIncludes(t *testing.T) {
+	lookup := func(id string) (string, bool) {
+		lre, ok := includeLREs[id]
+		return lre, ok
+	}
+	for _, tt := range expandIncludesTests {
+		out, err := ExpandIncludes("top", tt.in, lookup)
+		if err != nil {
+			if tt.err == "" {
+				t.Errorf("ExpandIncludes(%q): %v", tt.in, err)
+			} else if !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("ExpandIncludes(%q): have error %q, want %q", tt.in, err.Error(), tt.err)
+			} else if _, ok := err.(*SyntaxError); !ok {
+				t.Errorf("ExpandIncludes(%q): error %T, want *SyntaxError", tt.in, err)
+			}
+			continue
+		}
+		if tt.err != "" {
+			t.Errorf("ExpandIncludes(%q) = %q, want error %q", tt.in, out, tt.err)
+			continue
+		}
+		if out != tt.out {
+			t.Errorf("ExpandIncludes(%q) = %q, want %q", tt.in, out, tt.out)
+		}
+	}
+}
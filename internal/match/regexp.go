@@ -7,7 +7,7 @@
 package match
 
 import (
-	"fmt"
+	"io"
 	"sync"
 )
 
@@ -29,14 +29,40 @@ import (
 //	expr1 || expr2  - alternation
 //	(( expr ))      - grouping
 //	expr??          - zero or one instances of expr
+//	expr!!          - expr, required in a partial match
 //	//** text **//  - a comment
+//	[[word]]        - a punctuation-sensitive word
+//
+// A punctuation-sensitive word [[word]] matches the same text words
+// as word does, but only where the text spells them with the same
+// punctuation, ignoring case, and continues with no more letters or
+// digits before the next space. It may be several words, like [[2.0]],
+// but it must not contain spaces. Where the text could follow either
+// a [[word]] or a plain word, it follows the [[word]].
+//
+// A group marked (( expr ))!! matches just as (( expr )) does,
+// so it makes no difference to a complete match, which must include
+// all the text outside (( ))?? groups anyway. But a partial match,
+// reported by MultiLRE.MatchThreshold, or a match truncated by the end
+// of the text must get past every such group in the LRE, however many
+// words it covers. Marking the text that distinguishes a license
+// from its relatives keeps partial matches of their shared text
+// from claiming it. Required groups must not appear inside (( )).
+//
+// A line holding only the directive
+//
+//	<<section "name">>
+//
+// begins a named section of the LRE, running to the next such directive
+// or to the end of the LRE; see LRE.MatchSections.
+// Directives must not appear inside (( )).
 //
 // To make patterns harder to misread in large texts:
 //
 //	- || must only appear inside (( ))
-//	- ?? must only follow (( ))
+//	- ?? and !! must only follow (( ))
 //	- (( must be at the start of a line, preceded only by spaces
-//	- )) must be at the end of a line, followed only by spaces and ??.
+//	- )) must be at the end of a line, followed only by spaces and ?? or !!.
 //
 // For example:
 //
@@ -53,22 +79,44 @@ type LRE struct {
 	syntax *reSyntax
 	prog   reProg
 
+	text     string     // LRE text, if it has sections
+	sections []*section // sections marked in the LRE
+	fragment bool       // LRE matches only the opening of a longer text (see SetFragment)
+
 	onceDFA sync.Once
 	dfa     reDFA
+
+	required     reProg // program for the LRE through its last (( ))!! group, if any
+	onceRequired sync.Once
+	requiredDFA  reDFA
 }
 
 // ParseLRE parses the string s as a license regexp.
 // The file name is used in error messages if non-empty.
 func ParseLRE(d *Dict, file, s string) (*LRE, error) {
+	s, sections, err := parseSections(s)
+	if err != nil {
+		return nil, err
+	}
 	syntax, err := reParse(d, s, true)
 	if err != nil {
 		return nil, err
 	}
 	prog, err := syntax.compile(nil, 0)
 	if err != nil {
-		return nil, err
+		return nil, &SyntaxError{Offset: -1, Err: err.Error()}
 	}
-	return &LRE{dict: d, file: file, syntax: syntax, prog: prog}, nil
+	re := &LRE{dict: d, file: file, syntax: syntax, prog: prog, sections: sections}
+	if sections != nil {
+		re.text = s
+	}
+	if pre := syntax.requiredPrefix(); pre != nil {
+		re.required, err = pre.compile(nil, 0)
+		if err != nil {
+			return nil, &SyntaxError{Offset: -1, Err: err.Error()}
+		}
+	}
+	return re, nil
 }
 
 // Dict returns the Dict used by the LRE.
@@ -76,6 +124,37 @@ func (re *LRE) Dict() *Dict {
 	return re.dict
 }
 
+// Words returns the distinct words appearing in the LRE, in no particular order.
+func (re *LRE) Words() []WordID {
+	seen := make(map[WordID]bool)
+	re.syntax.addWords(seen)
+	list := make([]WordID, 0, len(seen))
+	for w := range seen {
+		list = append(list, w)
+	}
+	return list
+}
+
+// WordRange returns the minimum and maximum number of words in a match
+// of the LRE. Optional and alternative text and wildcards make them differ.
+// The counts are for the words as written in the LRE; spelling correction,
+// such as joining a hyphenated word broken across lines, can make
+// a match of the same text a word longer or shorter.
+func (re *LRE) WordRange() (min, max int) {
+	return re.syntax.wordRange()
+}
+
+// SetFragment marks the LRE as matching only the opening of a longer text,
+// such as the first sentence of a license quoted on its own.
+// A MultiLRE does not report a match of a fragment where the text
+// goes on to follow another LRE for at least minTruncatedWords more words,
+// since that text is a copy of the longer one, perhaps modified, not a fragment.
+// If the longer text is cut off by the end of the input instead,
+// the match is reported as truncated, as for any other LRE.
+func (re *LRE) SetFragment() {
+	re.fragment = true
+}
+
 // File returns the file name passed to ParseLRE.
 func (re *LRE) File() string {
 	return re.file
@@ -84,7 +163,22 @@ func (re *LRE) File() string {
 // Match reports whether text matches the license regexp.
 func (re *LRE) match(text string) bool {
 	re.onceDFA.Do(re.compile)
-	match, _ := re.dfa.match(re.dict, text, re.dict.Split(text))
+	match, _, _ := re.dfa.match(re.dict, text, re.dict.Split(text))
+	return match >= 0
+}
+
+// hasRequired reports whether words, which were split from text,
+// begin with a match of the LRE through its last required (( ))!! group,
+// so that a partial match of the LRE covering them includes every
+// required group. It reports true for an LRE with no required groups.
+func (re *LRE) hasRequired(text string, words []Word) bool {
+	if re.required == nil {
+		return true
+	}
+	re.onceRequired.Do(func() {
+		re.requiredDFA = reCompileDFA(re.required)
+	})
+	match, _, _, _, _ := re.requiredDFA.walk(re.dict, text, words)
 	return match >= 0
 }
 
@@ -98,13 +192,33 @@ func (re *LRE) compile() {
 // A MultiLRE matches multiple LREs simultaneously against a text.
 // It is more efficient than matching each LRE in sequence against the text.
 type MultiLRE struct {
-	dict *Dict // dict shared by all LREs
-	dfa  reDFA // compiled DFA for all LREs
+	dict *Dict  // dict shared by all LREs
+	dfa  reDFA  // compiled DFA for all LREs
+	list []*LRE // the LREs, for identifying a truncated match
 
 	// start contains the two-word phrases
 	// where a match can validly start,
 	// to allow for faster scans over non-license text.
 	start map[phrase]struct{}
+
+	// starts[i] contains the two-word phrases where
+	// a match of list[i] can validly start, so that the slow paths
+	// that run each LRE's own DFA can skip the LREs that cannot match.
+	starts []map[phrase]struct{}
+
+	// first reports, for each word ID, whether that word
+	// begins any phrase in start. Checking it before start
+	// rejects most words of non-license text without a map lookup.
+	//
+	// There is no further rejection of individual LREs whose rarer
+	// words are missing from the text: a truncated or partial match
+	// need not contain an LRE's later words, and spelling correction
+	// lets a text word match an LRE word it does not equal, so no such
+	// check could skip an LRE without changing some result. In text
+	// that is not a license, walking the DFA from the few phrases
+	// in start is a small part of the time; splitting the text into
+	// words is most of it (see BenchmarkScanSource).
+	first []bool
 }
 
 // A phrase is a phrase of up to two words.
@@ -115,6 +229,9 @@ type phrase [2]WordID
 // NewMultiLRE returns a MultiLRE looking for the given LREs.
 // All the LREs must have been parsed using the same Dict;
 // if not, NewMultiLRE panics.
+// If one of the LREs cannot begin a match, for example because
+// it begins with a wildcard, NewMultiLRE returns a *SyntaxError
+// with Offset -1 and File set to that LRE's file name.
 func NewMultiLRE(list []*LRE) (_ *MultiLRE, err error) {
 	if len(list) == 0 {
 		return &MultiLRE{}, nil
@@ -133,38 +250,62 @@ func NewMultiLRE(list []*LRE) (_ *MultiLRE, err error) {
 	}
 
 	start := make(map[phrase]struct{})
-	for _, sub := range list {
+	starts := make([]map[phrase]struct{}, len(list))
+	for i, sub := range list {
+		starts[i] = make(map[phrase]struct{})
 		phrases := sub.syntax.leadingPhrases()
 		if len(phrases) == 0 {
-			return nil, fmt.Errorf("%s: no leading phrases", sub.File())
+			return nil, patternError(sub, "no leading phrases")
 		}
 		for _, p := range phrases {
 			if p[0] == BadWord {
-				return nil, fmt.Errorf("%s: invalid pattern: matches empty text", sub.File())
+				return nil, patternError(sub, "invalid pattern: matches empty text")
 			}
 			if p[0] == AnyWord {
 				if p[1] == BadWord {
-					return nil, fmt.Errorf("%s: invalid pattern: matches a single wildcard", sub.File())
+					return nil, patternError(sub, "invalid pattern: matches a single wildcard")
 				}
 				if p[1] == AnyWord {
-					return nil, fmt.Errorf("%s: invalid pattern: begins with two wildcards", sub.File())
+					return nil, patternError(sub, "invalid pattern: begins with two wildcards")
 				}
-				return nil, fmt.Errorf("%s: invalid pattern: begins with wildcard phrase: __ %s", sub.File(), dict.Words()[p[1]])
+				return nil, patternError(sub, "invalid pattern: begins with wildcard phrase: __ "+dict.Words()[p[1]])
 			}
 			if p[1] == BadWord {
-				return nil, fmt.Errorf("%s: invalid pattern: matches single word %s", sub.File(), dict.Words()[p[0]])
+				return nil, patternError(sub, "invalid pattern: matches single word "+dict.Words()[p[0]])
 			}
 			if p[1] == AnyWord {
-				return nil, fmt.Errorf("%s: invalid pattern: begins with wildcard phrase: %s __", sub.File(), dict.Words()[p[0]])
+				return nil, patternError(sub, "invalid pattern: begins with wildcard phrase: "+dict.Words()[p[0]]+" __")
 			}
-			start[p] = struct{}{}
+			start[dict.textPhrase(p)] = struct{}{}
+			starts[i][dict.textPhrase(p)] = struct{}{}
 		}
 	}
 
+	first := make([]bool, len(dict.Words()))
+	for p := range start {
+		first[p[0]] = true
+	}
+
 	prog := reCompileMulti(progs)
 	dfa := reCompileDFA(prog)
 
-	return &MultiLRE{dict, dfa, start}, nil
+	return &MultiLRE{dict, dfa, list, start, starts, first}, nil
+}
+
+// patternError returns a *SyntaxError reporting that the LRE sub,
+// while valid on its own, cannot be used in a MultiLRE.
+func patternError(sub *LRE, msg string) error {
+	return &SyntaxError{File: sub.File(), Offset: -1, Err: msg}
+}
+
+// canStart reports whether a match of the i'th LRE can begin
+// with the first two of words.
+func (re *MultiLRE) canStart(i int, words []Word) bool {
+	if len(words) < 2 {
+		return false
+	}
+	_, ok := re.starts[i][phrase{words[0].ID, words[1].ID}]
+	return ok
 }
 
 // Dict returns the Dict used by the MultiLRE.
@@ -172,24 +313,77 @@ func (re *MultiLRE) Dict() *Dict {
 	return re.dict
 }
 
+// Words returns the distinct words appearing in any of the MultiLRE's LREs,
+// in no particular order.
+func (re *MultiLRE) Words() []WordID {
+	seen := make(map[WordID]bool)
+	for _, sub := range re.list {
+		sub.syntax.addWords(seen)
+	}
+	list := make([]WordID, 0, len(seen))
+	for w := range seen {
+		list = append(list, w)
+	}
+	return list
+}
+
+// LRE returns the i'th LRE in the list passed to NewMultiLRE.
+func (re *MultiLRE) LRE(i int) *LRE {
+	return re.list[i]
+}
+
+// WriteDOT writes the MultiLRE's compiled DFA to w in Graphviz DOT format,
+// for debugging. Accepting states are labeled by name(i),
+// where i is the index in the list passed to NewMultiLRE
+// of the LRE that matches in that state.
+func (re *MultiLRE) WriteDOT(w io.Writer, name func(i int) string) error {
+	return re.dfa.writeDOT(w, re.dict, func(m int32) string { return name(int(m)) })
+}
+
 // A Matches is a collection of all leftmost-longest, non-overlapping matches in text.
 type Matches struct {
 	Text  string  // the entire text
 	Words []Word  // the text, split into Words
 	List  []Match // the matches
+
+	// Truncated reports that the text ended partway through a possible
+	// match of an LRE. If so, the last entry in List is that partial match,
+	// ending at the end of the text.
+	Truncated bool
 }
 
 // A Match records the position of a single match in a text.
 type Match struct {
-	ID    int // index of LRE in list passed to NewMultiLRE
-	Start int // word index of start of match
-	End   int // word index of end of match
+	ID      int  // index of LRE in list passed to NewMultiLRE
+	Start   int  // word index of start of match
+	End     int  // word index of end of match
+	Partial bool // match covers only part of the LRE (see MatchThreshold)
 }
 
 // Match reports all leftmost-longest, non-overlapping matches in text.
 // It always returns a non-nil *Matches, in order to return the split text.
 // Check len(matches.List) to see whether any matches were found.
 func (re *MultiLRE) Match(text string) *Matches {
+	return re.MatchThreshold(text, 100)
+}
+
+// MatchThreshold is like Match but also reports partial matches:
+// where text begins to match an LRE but departs from it before the end,
+// and no complete match covers as many words, MatchThreshold reports
+// the LRE that text follows for the most words, as a Match with Partial set,
+// provided those words make up at least percent percent of the LRE's
+// shortest possible match, number at least minTruncatedWords,
+// and include every required (( ))!! group of the LRE.
+// A percent of 100 or more reports only complete matches, like Match.
+func (re *MultiLRE) MatchThreshold(text string, percent float64) *Matches {
+	return re.MatchFunc(text, percent, func(*Matches) bool { return true })
+}
+
+// MatchFunc is like MatchThreshold but calls yield each time it finds
+// a match, passing the matches so far, the last of which is the new one.
+// If yield returns false, MatchFunc stops searching and returns
+// the matches found so far.
+func (re *MultiLRE) MatchFunc(text string, percent float64, yield func(*Matches) bool) *Matches {
 	m := &Matches{
 		Text:  text,
 		Words: re.dict.Split(text),
@@ -197,11 +391,39 @@ func (re *MultiLRE) Match(text string) *Matches {
 	p := phrase{BadWord, BadWord}
 	for i := 0; i < len(m.Words); i++ {
 		p[0], p[1] = p[1], m.Words[i].ID
+		if p[0] < 0 || int(p[0]) >= len(re.first) || !re.first[p[0]] {
+			continue
+		}
 		if _, ok := re.start[p]; ok {
-			match, end := re.dfa.match(re.dict, text, m.Words[i-1:])
+			rest := m.Words[i-1:]
+			match, end, stop, any, off := re.dfa.walk(re.dict, text, rest)
+			if match >= 0 && re.list[match].fragment && stop-end >= minTruncatedWords {
+				// The text goes on past the fragment, following a longer LRE:
+				// it is a copy of that text, perhaps modified, not a fragment.
+				match, end = -1, 0
+			}
+			live := re.dfa.live(re.dict, text, rest, match, stop, any, off)
+			if live && len(m.Words)-(i-1) >= minTruncatedWords {
+				// The text ended in the middle of a possible match.
+				if id := re.truncated(text, m.Words[i-1:]); id >= 0 {
+					m.List = append(m.List, Match{ID: id, Start: i - 1, End: len(m.Words)})
+					m.Truncated = true
+					yield(m)
+					break
+				}
+			}
+			partial := false
+			if percent < 100 {
+				if id, n := re.partial(text, m.Words[i-1:], percent); id >= 0 && n > end {
+					match, end, partial = int32(id), n, true
+				}
+			}
 			if match >= 0 && end > 0 {
 				end += i - 1 // translate from index in m.Words[i-1:] to index in m.Words
-				m.List = append(m.List, Match{ID: int(match), Start: i - 1, End: end})
+				m.List = append(m.List, Match{ID: int(match), Start: i - 1, End: end, Partial: partial})
+				if !yield(m) {
+					break
+				}
 
 				// Continue search at end of match.
 				i = end - 1 // loop will i++
@@ -212,3 +434,111 @@ func (re *MultiLRE) Match(text string) *Matches {
 	}
 	return m
 }
+
+// Candidates returns the indices, in increasing order, of the LREs
+// that text could be the beginning of a match for.
+// Like Match, Candidates skips over text until a phrase that can
+// start a match, and it considers each such phrase in turn, returning
+// the LREs whose own DFAs can consume all the words from that phrase
+// to the end of text without reaching a dead end, for the first phrase
+// that has any. Because an LRE that has matched in full may still be
+// followed by more of the license, a complete match is also a candidate.
+// If no phrase in text can start a match, or if the text has departed
+// from every LRE after each such phrase, Candidates returns nil.
+func (re *MultiLRE) Candidates(text string) []int {
+	words := re.dict.Split(text)
+	p := phrase{BadWord, BadWord}
+	for i := 0; i < len(words); i++ {
+		p[0], p[1] = p[1], words[i].ID
+		if p[0] < 0 || int(p[0]) >= len(re.first) || !re.first[p[0]] {
+			continue
+		}
+		if _, ok := re.start[p]; !ok {
+			continue
+		}
+		var list []int
+		rest := words[i-1:]
+		for id, sub := range re.list {
+			if !re.canStart(id, rest) {
+				continue
+			}
+			sub.onceDFA.Do(sub.compile)
+			if _, _, stop, _, _ := sub.dfa.walk(re.dict, text, rest); stop == len(rest) {
+				list = append(list, id)
+			}
+		}
+		if list != nil {
+			return list
+		}
+	}
+	return nil
+}
+
+// minTruncatedWords is the minimum number of words in a reported
+// partial match at the end of a text. Shorter partial matches,
+// such as a trailing "the software", are too likely to be coincidence.
+const minTruncatedWords = 10
+
+// partial returns the index of the LRE that the start of words follows
+// for the most words before departing from it without matching it, along with that number of words,
+// for MatchThreshold. Only LREs for which the words make up at least
+// percent percent of the LRE's shortest possible match, and at least
+// minTruncatedWords words, and that include every required group
+// of the LRE, are considered. If there is no such LRE,
+// partial returns -1, 0.
+//
+// Like truncated, partial runs the own DFA of each LRE that can start
+// with words, so it is slow; it runs only when MatchThreshold is asked
+// for partial matches.
+func (re *MultiLRE) partial(text string, words []Word, percent float64) (id, n int) {
+	id = -1
+	for i, sub := range re.list {
+		if !re.canStart(i, words) {
+			continue
+		}
+		sub.onceDFA.Do(sub.compile)
+		match, _, stop, _, _ := sub.dfa.walk(re.dict, text, words)
+		if match >= 0 || stop <= n || stop < minTruncatedWords {
+			// Complete matches are found by the MultiLRE's own DFA.
+			continue
+		}
+		if min, _ := sub.WordRange(); float64(stop) >= percent/100*float64(min) && sub.hasRequired(text, words[:stop]) {
+			id, n = i, stop
+		}
+	}
+	return id, n
+}
+
+// truncated returns the index of the LRE that words,
+// which run to the end of text, begin to match but end too soon to complete.
+// If the words begin to match several LREs with different file names,
+// which for a Scanner are license IDs, as when the end of the text
+// cuts off one of the BSD licenses before the clauses that tell them
+// apart, there is no telling which license the text is, and truncated
+// returns -1. Otherwise it returns the first such LRE, or -1 if there is none.
+// An LRE counts only if words get past all its required (( ))!! groups.
+//
+// The MultiLRE's DFA cannot tell which of its LREs was making progress
+// at the end of the text, so truncated runs the own DFA of each LRE
+// that can start with words, compiling it on first use.
+// That is slow, but it happens only when the MultiLRE's DFA is still
+// making progress at the end of the text, which is rarely true
+// for more than one starting point in a text.
+func (re *MultiLRE) truncated(text string, words []Word) int {
+	id := -1
+	for i, sub := range re.list {
+		if !re.canStart(i, words) {
+			continue
+		}
+		sub.onceDFA.Do(sub.compile)
+		if _, _, live := sub.dfa.match(re.dict, text, words); !live || !sub.hasRequired(text, words) {
+			continue
+		}
+		if id < 0 {
+			id = i
+		} else if sub.file != re.list[id].file {
+			return -1
+		}
+	}
+	return id
+}
@@ -16,10 +16,12 @@ var multiMatchTests = []struct {
 	in   string
 	list []Match
 }{
-	{"a\n((b || c))\nd", `a b d`, []Match{{0, 0, 3}}},
+	{"a\n((b || c))\nd", `a b d`, []Match{{0, 0, 3, false}}},
 	{"a\n((b || c))\nd", `a b c d`, nil},
-	{"a b c / a\n((c || d))\ne", `a b c x a c e x a d e x`, []Match{{0, 0, 3}, {1, 4, 7}, {1, 8, 11}}},
-	{"a b c / a b c d / b c e", `a b c d e a b c b c e`, []Match{{1, 0, 4}, {0, 5, 8}, {2, 8, 11}}},
+	{"a b c / a\n((c || d))\ne", `a b c x a c e x a d e x`, []Match{{0, 0, 3, false}, {1, 4, 7, false}, {1, 8, 11, false}}},
+	{"a b c / a b c d / b c e", `a b c d e a b c b c e`, []Match{{1, 0, 4, false}, {0, 5, 8, false}, {2, 8, 11, false}}},
+	{"[[C++]] rocks / C rocks", `C rocks, C++ rocks`, []Match{{1, 0, 2, false}, {0, 2, 4, false}}},
+	{"version [[2.0]] / version 2 0", `version 2.0 version 2-0`, []Match{{0, 0, 3, false}, {1, 3, 6, false}}},
 }
 
 func TestMultiLREMatch(t *testing.T) {
@@ -56,3 +58,249 @@ func TestMultiLREMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiLREMatchThreshold(t *testing.T) {
+	var d Dict
+	var list []*LRE
+	for _, expr := range []string{"a b c d e f g h i j k l m n o p q r s t", "x y z"} {
+		re, err := ParseLRE(&d, "x", expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", expr, err)
+		}
+		list = append(list, re)
+	}
+	re, err := NewMultiLRE(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := "a b c d e f g h i j k l x y z q"
+	for _, tt := range []struct {
+		percent float64
+		list    []Match
+	}{
+		{100, []Match{{1, 12, 15, false}}},
+		{70, []Match{{1, 12, 15, false}}},
+		{60, []Match{{0, 0, 12, true}, {1, 12, 15, false}}},
+		{10, []Match{{0, 0, 12, true}, {1, 12, 15, false}}},
+	} {
+		if m := re.MatchThreshold(in, tt.percent); !reflect.DeepEqual(m.List, tt.list) {
+			t.Errorf("MatchThreshold(%v):\nhave %+v\nwant %+v", tt.percent, m.List, tt.list)
+		}
+	}
+
+	// Partial matches must also be long enough not to be coincidence.
+	if m := re.MatchThreshold("a b c d x y z", 10); len(m.List) != 1 || m.List[0].Partial {
+		t.Errorf("MatchThreshold(short prefix) = %+v, want only x y z", m.List)
+	}
+}
+
+func TestMultiLREMatchFunc(t *testing.T) {
+	var d Dict
+	var list []*LRE
+	for _, expr := range []string{"a b c", "x y z"} {
+		re, err := ParseLRE(&d, "x", expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", expr, err)
+		}
+		list = append(list, re)
+	}
+	re, err := NewMultiLRE(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := "a b c q x y z q a b c"
+	calls := 0
+	m := re.MatchFunc(in, 100, func(m *Matches) bool {
+		calls++
+		return len(m.List) < 2
+	})
+	if want := []Match{{0, 0, 3, false}, {1, 4, 7, false}}; !reflect.DeepEqual(m.List, want) || calls != 2 {
+		t.Errorf("MatchFunc stopping after 2:\nhave %+v after %d calls\nwant %+v after 2 calls", m.List, calls, want)
+	}
+	if m := re.MatchFunc(in, 100, func(*Matches) bool { return true }); len(m.List) != 3 {
+		t.Errorf("MatchFunc = %+v, want 3 matches", m.List)
+	}
+}
+
+func TestMultiLREFragment(t *testing.T) {
+	var d Dict
+	var list []*LRE
+	for _, expr := range []string{"a b c d e f g h i j k l m n o p q r s t", "a b c d", "x y z"} {
+		re, err := ParseLRE(&d, "x", expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", expr, err)
+		}
+		list = append(list, re)
+	}
+	list[1].SetFragment()
+	re, err := NewMultiLRE(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		in        string
+		list      []Match
+		truncated bool
+	}{
+		// The fragment standing alone.
+		{"a b c d", []Match{{1, 0, 4, false}}, false},
+		{"a b c d x y z", []Match{{1, 0, 4, false}, {2, 4, 7, false}}, false},
+		{"a b c d e f g x y z", []Match{{1, 0, 4, false}, {2, 7, 10, false}}, false},
+
+		// Text that goes on to copy the longer LRE is not a fragment.
+		{"a b c d e f g h i j k l m n x y z", []Match{{2, 14, 17, false}}, false},
+		{"a b c d e f g h i j k l m n o p q r s t", []Match{{0, 0, 20, false}}, false},
+		{"a b c d e f g h i j k l m n", []Match{{0, 0, 14, false}}, true},
+	} {
+		m := re.Match(tt.in)
+		if !reflect.DeepEqual(m.List, tt.list) || m.Truncated != tt.truncated {
+			t.Errorf("Match(%q):\nhave %+v, truncated=%v\nwant %+v, truncated=%v", tt.in, m.List, m.Truncated, tt.list, tt.truncated)
+		}
+	}
+}
+
+func TestMultiLRETruncated(t *testing.T) {
+	var d Dict
+	var list []*LRE
+	for _, lre := range []struct{ file, expr string }{
+		{"long", "alpha bravo charlie delta echo foxtrot golf hotel india juliet kilo lima"},
+		{"long", "see the long license"},
+		{"short", "alpha bravo charlie delta echo foxtrot golf hotel india juliet mike"},
+		{"other", "one two three four five six seven eight nine ten eleven twelve"},
+	} {
+		re, err := ParseLRE(&d, lre.file, lre.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", lre.expr, err)
+		}
+		list = append(list, re)
+	}
+	re, err := NewMultiLRE(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		in   string
+		list []Match
+	}{
+		{"one two three four five six seven eight nine ten", []Match{{3, 0, 10, false}}},
+		{"one two three four five six seven eight nine ten ele", []Match{{3, 0, 11, false}}},
+		{"one two three four five six seven eight nine ten xyz", nil},
+		{"one two three four five six seven eight nine ten ele\n", nil},
+
+		// The text follows two LREs for different files to its end,
+		// so it is not known which of them it is.
+		{"alpha bravo charlie delta echo foxtrot golf hotel india juliet", nil},
+		{"alpha bravo charlie delta echo foxtrot golf hotel india juliet ki", []Match{{0, 0, 11, false}}},
+	} {
+		m := re.Match(tt.in)
+		if !reflect.DeepEqual(m.List, tt.list) || m.Truncated != (tt.list != nil) {
+			t.Errorf("Match(%q):\nhave %+v, truncated=%v\nwant %+v, truncated=%v", tt.in, m.List, m.Truncated, tt.list, tt.list != nil)
+		}
+	}
+}
+
+func TestMultiLRERequired(t *testing.T) {
+	var d Dict
+	var list []*LRE
+	for _, expr := range []string{"a b c d e f g h i j k l\n((m n))!!\no p q r s t", "x y z"} {
+		re, err := ParseLRE(&d, "x", expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", expr, err)
+		}
+		list = append(list, re)
+	}
+	re, err := NewMultiLRE(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		in        string
+		list      []Match
+		truncated bool
+	}{
+		// A complete match includes the required group anyway.
+		{"a b c d e f g h i j k l m n o p q r s t", []Match{{0, 0, 20, false}}, false},
+
+		// A partial or truncated match must get past the required group.
+		{"a b c d e f g h i j k l x y z", []Match{{1, 12, 15, false}}, false},
+		{"a b c d e f g h i j k l m n o x y z", []Match{{0, 0, 15, true}, {1, 15, 18, false}}, false},
+		{"a b c d e f g h i j k l m", nil, false},
+		{"a b c d e f g h i j k l m n o", []Match{{0, 0, 15, false}}, true},
+	} {
+		m := re.MatchThreshold(tt.in, 10)
+		if !reflect.DeepEqual(m.List, tt.list) || m.Truncated != tt.truncated {
+			t.Errorf("MatchThreshold(%q, 10):\nhave %+v, truncated=%v\nwant %+v, truncated=%v", tt.in, m.List, m.Truncated, tt.list, tt.truncated)
+		}
+	}
+}
+
+func TestMultiLREWriteDOT(t *testing.T) {
+	var d Dict
+	var list []*LRE
+	for _, expr := range []string{"a b c", "x\n((b || d))\ne"} {
+		re, err := ParseLRE(&d, "x", expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", expr, err)
+		}
+		list = append(list, re)
+	}
+	re, err := NewMultiLRE(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := re.WriteDOT(&b, func(i int) string { return fmt.Sprint("L", i) }); err != nil {
+		t.Fatal(err)
+	}
+	want := `digraph dfa {
+	rankdir=LR;
+	node [shape=circle];
+	s0 [label="0"];
+	s0 -> s5 [label="a"];
+	s0 -> s13 [label="x"];
+	s5 [label="5"];
+	s5 -> s8 [label="b"];
+	s8 [label="8"];
+	s8 -> s11 [label="c"];
+	s11 [shape=doublecircle, label="11\nL0"];
+	s13 [label="13"];
+	s13 -> s18 [label="b d"];
+	s18 [label="18"];
+	s18 -> s21 [label="e"];
+	s21 [shape=doublecircle, label="21\nL1"];
+}
+`
+	if out := b.String(); out != want {
+		t.Errorf("WriteDOT:\nhave:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+var wordRangeTests = []struct {
+	re       string
+	min, max int
+}{
+	{"a b c", 3, 3},
+	{"a\n((b c))??\nd", 2, 4},
+	{"a\n((b || c d e))\nf", 3, 5},
+	{"a __5__ b", 2, 7},
+	{"a\n((b __3__ c || d))??\ne", 2, 7},
+	{"a\n((b || c d))!!\ne", 3, 4},
+}
+
+func TestLREWordRange(t *testing.T) {
+	var d Dict
+	for _, tt := range wordRangeTests {
+		re, err := ParseLRE(&d, "x", tt.re)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.re, err)
+		}
+		if min, max := re.WordRange(); min != tt.min || max != tt.max {
+			t.Errorf("ParseLRE(%q).WordRange() = %d, %d, want %d, %d", tt.re, min, max, tt.min, tt.max)
+		}
+	}
+}
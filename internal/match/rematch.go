@@ -198,8 +198,10 @@
 package match
 
 import (
+	"bufio"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 )
@@ -308,6 +310,9 @@ func (c *reCompile) compile(re *reSyntax) {
 			c.compile(sub)
 		}
 
+	case opRequired:
+		c.compile(re.sub[0])
+
 	case opQuest:
 		alt := len(c.prog)
 		c.prog = append(c.prog, reInst{op: instAlt})
@@ -352,6 +357,41 @@ func (c *reCompile) compile(re *reSyntax) {
 			c.prog[j].arg = int32(end - (j + 1))
 		}
 
+	case opPriority:
+		// Compile ((A |> B |> C)) as if it were ((B |> C || A)),
+		// with each alternative after the first compiled recursively,
+		// and with a cut after A of the alt range holding B |> C:
+		//
+		//	L0:	alt L1
+		//		B |> C
+		//		jump L2
+		//	L1:	A
+		//		cut [L0, L1]
+		//	L2:
+		//
+		// When A completes, any threads still part way through
+		// the later alternatives are cut off.
+		cut := c.cut
+		endPattern := c.endPattern
+		alt := len(c.prog)
+		c.prog = append(c.prog, reInst{op: instAlt})
+		rest := re.sub[1:]
+		if len(rest) == 1 {
+			c.compile(rest[0])
+		} else {
+			c.compile(&reSyntax{op: opPriority, sub: rest})
+		}
+		restCut := c.cut
+		jump := len(c.prog)
+		c.prog = append(c.prog, reInst{op: instJump})
+		c.prog[alt].arg = int32(len(c.prog) - (alt + 1))
+		c.cut = cut
+		c.endPattern = endPattern
+		c.compile(re.sub[0])
+		c.compileCut(reCut{start: alt})
+		c.cut = c.mergeCut(restCut, c.cut)
+		c.prog[jump].arg = int32(len(c.prog) - (jump + 1))
+
 	case opWild:
 		// All alts jump to the end of the expression, as if it were
 		//	(.(.(.(.)?)?)?)?
@@ -435,7 +475,7 @@ func (c *reCompile) mergeCut(cut1, cut2 []reCut) []reCut {
 // canMatchEmpty reports whether re can match an empty text.
 func canMatchEmpty(re *reSyntax) bool {
 	switch re.op {
-	case opAlternate:
+	case opAlternate, opPriority:
 		for _, sub := range re.sub {
 			if canMatchEmpty(sub) {
 				return true
@@ -450,6 +490,9 @@ func canMatchEmpty(re *reSyntax) bool {
 			}
 		}
 
+	case opRequired:
+		return canMatchEmpty(re.sub[0])
+
 	case opWords:
 		if len(re.w) > 0 {
 			return false
@@ -771,6 +814,53 @@ func (dfa reDFA) string(d *Dict) string {
 	return b.String()
 }
 
+// writeDOT writes the DFA to w in Graphviz DOT format.
+// The dictionary d supplies the words labeling the transitions,
+// and name supplies the label for each match value.
+// Transitions from one state to another on different words
+// are merged into a single edge listing all the words.
+func (dfa reDFA) writeDOT(w io.Writer, d *Dict, name func(match int32) string) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "digraph dfa {\n")
+	fmt.Fprintf(b, "\trankdir=LR;\n")
+	fmt.Fprintf(b, "\tnode [shape=circle];\n")
+	for i := 0; i < len(dfa); {
+		m, delta := dfa.stateAt(int32(i))
+		if m >= 0 {
+			fmt.Fprintf(b, "\ts%d [shape=doublecircle, label=%q];\n", i, fmt.Sprintf("%d\n%s", i, name(m)))
+		} else {
+			fmt.Fprintf(b, "\ts%d [label=\"%d\"];\n", i, i)
+		}
+
+		var targets []int32
+		labels := make(map[int32][]string)
+		for j := 0; j < len(delta); j += 2 {
+			w, next := WordID(delta[j]), delta[j+1]
+			if next < 0 {
+				continue
+			}
+			if labels[next] == nil {
+				targets = append(targets, next)
+			}
+			if w == AnyWord {
+				labels[next] = append(labels[next], "*")
+			} else {
+				labels[next] = append(labels[next], d.Words()[w])
+			}
+		}
+		for _, next := range targets {
+			fmt.Fprintf(b, "\ts%d -> s%d [label=%q];\n", i, next, strings.Join(labels[next], " "))
+		}
+
+		i += 1 + len(delta)
+		if m >= 0 {
+			i++
+		}
+	}
+	fmt.Fprintf(b, "}\n")
+	return b.Flush()
+}
+
 // stateAt returns (partly) decoded information about the
 // DFA state at the given offset.
 // If the state is a matching state, stateAt returns match >= 0 specifies the match ID.
@@ -808,9 +898,54 @@ var TraceDFA int
 // match returns the match ID of the longest match, as well as
 // the index in words immediately following the last matched word.
 // If there is no match, match returns -1, 0.
-func (dfa reDFA) match(dict *Dict, text string, words []Word) (match int32, end int) {
+//
+// If no match was found but words ran out while the DFA was still making
+// progress toward one, with the last word matched literally rather than
+// by a wildcard, match also reports live = true (see live).
+func (dfa reDFA) match(dict *Dict, text string, words []Word) (match int32, end int, live bool) {
+	match, end, stop, any, off := dfa.walk(dict, text, words)
+	return match, end, dfa.live(dict, text, words, match, stop, any, off)
+}
+
+// live reports whether a walk over words, with the given results,
+// found no match but was still making progress toward one, with its
+// last step matching a word literally rather than by a wildcard,
+// when the words ran out at the end of text.
+// The end of text may cut its last word short, as when reading only
+// a prefix of a large file, so live also reports true if the walk
+// reached a dead end at the last word, that word ends the text,
+// and it is the beginning of a word that the DFA expected there.
+func (dfa reDFA) live(dict *Dict, text string, words []Word, match int32, stop int, any bool, off int32) bool {
+	if match >= 0 || len(words) == 0 || any {
+		return false
+	}
+	if stop == len(words) {
+		return true
+	}
+	last := words[len(words)-1]
+	if stop != len(words)-1 || int(last.Hi) != len(text) {
+		return false
+	}
+	have := toFold(text[last.Lo:last.Hi])
+	_, delta := dfa.stateAt(off)
+	dictWords := dict.Words()
+	for j := 0; j < len(delta); j += 2 {
+		if w := WordID(delta[j]); w >= 0 && !dict.isExact(w) && strings.HasPrefix(dictWords[w], have) {
+			return true
+		}
+	}
+	return false
+}
+
+// walk implements match, running the DFA over words until it
+// reaches a dead end or the words run out. In addition to the
+// longest match, walk reports the index in words of the word
+// at which the DFA reached a dead end, or len(words) if it did not,
+// whether its last step was by a wildcard, and the offset of the
+// DFA state it was in when it stopped.
+func (dfa reDFA) walk(dict *Dict, text string, words []Word) (match int32, end, stop int, any bool, off int32) {
 	match, end = -1, 0
-	off := int32(0) // offset of current state in DFA
+	off = 0 // offset of current state in DFA
 	dictWords := dict.Words()
 
 	// No range loop here: misspellings can adjust i.
@@ -834,9 +969,23 @@ Words:
 			delta = delta[2:]
 		}
 
+		// A punctuation-sensitive word takes priority over the plain word,
+		// so that the text "C++" follows [[C++]] rather than C.
+		if len(dict.exact) > 0 {
+			for j := 0; j < len(delta); j += 2 {
+				if n := dict.matchExact(WordID(delta[j]), text, words[i:]); n > 0 {
+					off = delta[j+1]
+					any = false
+					i += n - 1 // loop will i++
+					continue Words
+				}
+			}
+		}
+
 		for j := 0; j < len(delta); j += 2 {
 			if WordID(delta[j]) == w {
 				off = delta[j+1]
+				any = false
 				continue Words
 			}
 		}
@@ -856,12 +1005,17 @@ Words:
 
 		for j := 0; j < len(delta); j += 2 {
 			dw, dnext := WordID(delta[j]), delta[j+1]
+			if dict.isExact(dw) {
+				// Only the exact spelling can match.
+				continue
+			}
 			want := dictWords[dw]
 
 			// Can we spell want by joining have and have2?
 			// This can happen with hyphenated line breaks.
 			if canMisspellJoin(want, have, have2) {
 				off = dnext
+				any = false
 				i++ // for have; loop will i++ again for have2
 				continue Words
 			}
@@ -889,6 +1043,7 @@ Words:
 						end = i
 					}
 					off = next2
+					any = false
 					continue Words
 				}
 			}
@@ -896,6 +1051,7 @@ Words:
 			// Can we misspell want as have?
 			if canMisspell(want, have) {
 				off = dnext
+				any = false
 				continue Words
 			}
 		}
@@ -922,9 +1078,10 @@ Words:
 			}
 
 			// Return best match we found.
-			return match, end
+			return match, end, i, any, off
 		}
 		off = nextAny
+		any = true
 	}
 
 	if m, _ := dfa.stateAt(off); m >= 0 {
@@ -938,7 +1095,7 @@ Words:
 		}
 		println("DFA ran out of input at «", text[words[start].Lo:], "|", "EOF", "»\n")
 	}
-	return match, end
+	return match, end, len(words), any, off
 }
 
 func sortInt32s(x []int32) {
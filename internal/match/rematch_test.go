@@ -32,6 +32,30 @@ a ((b || c)) d
 5	word d
 6	match 0
 
+a ((b |> c)) d
+0	word a
+1	alt 4
+2	word c
+3	jump 6
+4	word b
+5	cut [1, 4]
+6	word d
+7	match 0
+
+a ((b |> c |> d)) e
+0	word a
+1	alt 8
+2	alt 5
+3	word d
+4	jump 7
+5	word c
+6	cut [2, 5]
+7	jump 10
+8	word b
+9	cut [1, 8]
+10	word e
+11	match 0
+
 a __3__ b
 0	word a
 1	alt 7
@@ -646,6 +670,37 @@ var matchTests = []struct {
 	{`a b ((__5__ c d e))??`, `a b X X X c d e`, 0, 8},
 
 	{`a b __5__ c d ((x?? y?? z z z || y w w w))`, `a b X X X c d y z z z`, 0, 11},
+
+	// Priority alternation: once an alternative matches,
+	// the later ones still in progress are abandoned,
+	// even if they would have made a longer match.
+	{`a ((b c || b c d e))`, `a b c d e`, 0, 5},
+	{`a ((b c |> b c d e))`, `a b c d e`, 0, 3},
+	{`a ((b c d e |> b c))`, `a b c d e`, 0, 5},
+	{`a ((b c d e |> b c))`, `a b c x`, 0, 3},
+	{`a ((b |> b c |> b c d))`, `a b c d`, 0, 2},
+	{`a ((b c d |> b c |> b))`, `a b c x`, 0, 3},
+	{`a ((b || b c)) d`, `a b c d`, 0, 4},
+	{`a ((b |> b c)) d`, `a b c d`, -1, 0},
+	{`a ((b |> b c)) c d`, `a b c d`, 0, 4},
+	{`a ((b |> c)) d`, `a c d`, 0, 3},
+
+	// Punctuation-sensitive words
+	{`use [[C++]] here`, `use C++ here`, 0, 3},
+	{`use [[C++]] here`, `use c++, here`, 0, 3},
+	{`use [[C++]] here`, `use C here`, -1, 0},
+	{`use [[C++]] here`, `use C+ here`, -1, 0},
+	{`use [[C++]] here`, `use C++x here`, -1, 0},
+	{`use C here`, `use C++ here`, 0, 3},
+	{`version [[2.0]] only`, `version 2.0 only`, 0, 4},
+	{`version [[2.0]] only`, `Version 2.0) only`, 0, 4},
+	{`version [[2.0]] only`, `version 2-0 only`, -1, 0},
+	{`version [[2.0]] only`, `version 2.0.1 only`, -1, 0},
+	{`the [[.NET]] framework`, `the .NET framework`, 0, 3},
+	{`the [[.NET]] framework`, `the NET framework`, -1, 0},
+	{`a ((c b || [[c++]] d))`, `a c b`, 0, 3},
+	{`a ((c b || [[c++]] d))`, `a c++ d`, 0, 3},
+	{`a ((c b || [[c++]] d))`, `a c++ b`, -1, 0}, // [[c++]] takes priority over c
 }
 
 func TestReDFAMatch(t *testing.T) {
@@ -656,7 +711,7 @@ func TestReDFAMatch(t *testing.T) {
 			continue
 		}
 		dfa := reCompileDFA(prog)
-		match, end := dfa.match(&d, tt.in, d.Split(tt.in))
+		match, end, _ := dfa.match(&d, tt.in, d.Split(tt.in))
 		if match != tt.match || end != tt.end {
 			t.Errorf("reDFA(%q).match(%v) = %v, %v, want %v, %v", tt.re, tt.in, match, end, tt.match, tt.end)
 		}
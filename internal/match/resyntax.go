@@ -16,6 +16,8 @@ import (
 )
 
 // A SyntaxError reports a syntax error during parsing.
+// An error that is not at a particular place in the LRE,
+// such as a pattern that cannot start a match, has Offset -1.
 type SyntaxError struct {
 	File    string
 	Offset  int
@@ -24,6 +26,12 @@ type SyntaxError struct {
 }
 
 func (e *SyntaxError) Error() string {
+	if e.Offset < 0 {
+		if e.File != "" {
+			return e.File + ": " + e.Err
+		}
+		return e.Err
+	}
 	var text string
 	if e.File != "" {
 		text = fmt.Sprintf("%s:#%d: syntax error", e.File, e.Offset)
@@ -62,7 +70,7 @@ func reSyntaxError(s string, i int, err error) error {
 // A reSyntax is a regexp syntax tree.
 type reSyntax struct {
 	op  reOp        // opcode
-	sub []*reSyntax // subexpressions (opConcat, opAlternate, opWild, opQuest)
+	sub []*reSyntax // subexpressions (opConcat, opAlternate, opPriority, opWild, opQuest, opRequired)
 	w   []WordID    // words (opWords)
 	n   int32       // wildcard count (opWild)
 }
@@ -78,13 +86,62 @@ const (
 	opAlternate
 	opWild
 	opQuest
+	opPriority // like opAlternate, but earlier subexpressions take priority
+	opRequired // like its subexpression, but a partial match must include it
 
 	// pseudo-ops during parsing
 	opPseudo
 	opLeftParen
 	opVerticalBar
+	opPriorityBar
 )
 
+// addWords adds to seen the words appearing in the regexp syntax.
+func (re *reSyntax) addWords(seen map[WordID]bool) {
+	for _, w := range re.w {
+		seen[w] = true
+	}
+	for _, sub := range re.sub {
+		sub.addWords(seen)
+	}
+}
+
+// wordRange returns the minimum and maximum number of words
+// in a match of the regexp syntax, not counting any
+// changes made by spelling correction.
+func (re *reSyntax) wordRange() (min, max int) {
+	switch re.op {
+	case opWords:
+		return len(re.w), len(re.w)
+	case opWild:
+		return 0, int(re.n)
+	case opQuest:
+		_, max = re.sub[0].wordRange()
+		return 0, max
+	case opRequired:
+		return re.sub[0].wordRange()
+	case opConcat:
+		for _, sub := range re.sub {
+			lo, hi := sub.wordRange()
+			min += lo
+			max += hi
+		}
+		return min, max
+	case opAlternate, opPriority:
+		for i, sub := range re.sub {
+			lo, hi := sub.wordRange()
+			if i == 0 || lo < min {
+				min = lo
+			}
+			if hi > max {
+				max = hi
+			}
+		}
+		return min, max
+	}
+	return 0, 0
+}
+
 // string returns a text form for the regexp syntax.
 // The dictionary d supplies the word literals.
 func (re *reSyntax) string(d *Dict) string {
@@ -126,12 +183,16 @@ func rePrint(b *bytes.Buffer, re *reSyntax, d *Dict) {
 			rePrint(b, sub, d)
 		}
 
-	case opAlternate:
+	case opAlternate, opPriority:
 		nl(b)
 		b.WriteString("((")
+		bar := " || "
+		if re.op == opPriority {
+			bar = " |> "
+		}
 		for i, sub := range re.sub {
 			if i > 0 {
-				b.WriteString(" || ")
+				b.WriteString(bar)
 			}
 			rePrint(b, sub, d)
 		}
@@ -143,7 +204,7 @@ func rePrint(b *bytes.Buffer, re *reSyntax, d *Dict) {
 	case opQuest:
 		sub := re.sub[0]
 		nl(b)
-		if sub.op == opAlternate {
+		if sub.op == opAlternate || sub.op == opPriority {
 			rePrint(b, sub, d)
 			b.Truncate(b.Len() - 1) // strip \n
 		} else {
@@ -153,6 +214,19 @@ func rePrint(b *bytes.Buffer, re *reSyntax, d *Dict) {
 		}
 		b.WriteString("??\n")
 
+	case opRequired:
+		sub := re.sub[0]
+		nl(b)
+		if sub.op == opAlternate || sub.op == opPriority {
+			rePrint(b, sub, d)
+			b.Truncate(b.Len() - 1) // strip \n
+		} else {
+			b.WriteString("((")
+			rePrint(b, sub, d)
+			b.WriteString("))")
+		}
+		b.WriteString("!!\n")
+
 	case opWords:
 		if len(re.w) == 0 {
 			b.WriteString("«empty opWords»")
@@ -197,7 +271,7 @@ func reParse(d *Dict, s string, strict bool) (*reSyntax, error) {
 			if strict && !atBOL(s, i) {
 				return nil, reSyntaxError(s, i, fmt.Errorf("(( not at beginning of line"))
 			}
-			p.words(s[start:i], "((")
+			p.words(s[start:i], s[i:], "((")
 			p.push(&reSyntax{op: opLeftParen})
 			i += 2
 			start = i
@@ -207,26 +281,37 @@ func reParse(d *Dict, s string, strict bool) (*reSyntax, error) {
 			if strict && parens == 0 {
 				return nil, reSyntaxError(s, i, fmt.Errorf("|| outside (( ))"))
 			}
-			p.words(s[start:i], "||")
-			if err := p.verticalBar(); err != nil {
+			p.words(s[start:i], s[i:], "||")
+			if err := p.verticalBar(opVerticalBar); err != nil {
+				return nil, reSyntaxError(s, i, err)
+			}
+			i += 2
+			start = i
+
+		case strings.HasPrefix(s[i:], "|>"):
+			if strict && parens == 0 {
+				return nil, reSyntaxError(s, i, fmt.Errorf("|> outside (( ))"))
+			}
+			p.words(s[start:i], s[i:], "|>")
+			if err := p.verticalBar(opPriorityBar); err != nil {
 				return nil, reSyntaxError(s, i, err)
 			}
 			i += 2
 			start = i
 
 		case strings.HasPrefix(s[i:], "))"):
-			// )) must be followed by ?? or end line
+			// )) must be followed by ??, !!, or end line
 			if strict {
 				j := i + 2
 				for j < len(s) && (s[j] == ' ' || s[j] == '\t') {
 					j++
 				}
-				if j < len(s) && s[j] != '\n' && (j+1 >= len(s) || s[j] != '?' || s[j+1] != '?') {
+				if j < len(s) && s[j] != '\n' && !strings.HasPrefix(s[j:], "??") && !strings.HasPrefix(s[j:], "!!") {
 					return nil, reSyntaxError(s, i, fmt.Errorf(")) not at end of line"))
 				}
 			}
 
-			p.words(s[start:i], "))")
+			p.words(s[start:i], s[i:], "))")
 			if err := p.rightParen(); err != nil {
 				return nil, reSyntaxError(s, i, err)
 			}
@@ -249,13 +334,38 @@ func reParse(d *Dict, s string, strict bool) (*reSyntax, error) {
 				return nil, reSyntaxError(s, i, fmt.Errorf("?? not at end of line"))
 			}
 
-			p.words(s[start:i], "??")
+			p.words(s[start:i], s[i:], "??")
 			if err := p.quest(); err != nil {
 				return nil, reSyntaxError(s, i, err)
 			}
 			i += 2
 			start = i
 
+		case strings.HasPrefix(s[i:], "!!"):
+			// !! must be preceded by )) on same line and must end the line.
+			if strict {
+				j := i
+				for j > 0 && (s[j-1] == ' ' || s[j-1] == '\t') {
+					j--
+				}
+				if j < 2 || s[j-1] != ')' || s[j-2] != ')' {
+					return nil, reSyntaxError(s, i, fmt.Errorf("!! not preceded by ))"))
+				}
+			}
+			if strict && !atEOL(s, i+2) {
+				return nil, reSyntaxError(s, i, fmt.Errorf("!! not at end of line"))
+			}
+			if parens != 0 {
+				return nil, reSyntaxError(s, i, fmt.Errorf("!! inside (( ))"))
+			}
+
+			p.words(s[start:i], s[i:], "!!")
+			if err := p.required(); err != nil {
+				return nil, reSyntaxError(s, i, err)
+			}
+			i += 2
+			start = i
+
 		case strings.HasPrefix(s[i:], "__"):
 			j := i + 2
 			for j < len(s) && '0' <= s[j] && s[j] <= '9' {
@@ -273,17 +383,31 @@ func reParse(d *Dict, s string, strict bool) (*reSyntax, error) {
 			if err != nil {
 				return nil, reSyntaxError(s, i, errors.New("invalid wildcard count "+s[i:j+2]))
 			}
-			p.words(s[start:i], "__")
+			p.words(s[start:i], s[i:], "__")
 			p.push(&reSyntax{op: opWild, n: int32(n)})
 			i = j + 2
 			start = i
 
+		case strings.HasPrefix(s[i:], "[["):
+			j := strings.Index(s[i+2:], "]]")
+			if j < 0 {
+				return nil, reSyntaxError(s, i, errors.New("opening [[ without closing ]]"))
+			}
+			p.words(s[start:i], s[i:], "[[")
+			w, err := p.dict.insertExact(s[i+2 : i+2+j])
+			if err != nil {
+				return nil, reSyntaxError(s, i, err)
+			}
+			p.word(w)
+			i += 2 + j + 2
+			start = i
+
 		case strings.HasPrefix(s[i:], "//**"):
 			j := strings.Index(s[i+4:], "**//")
 			if j < 0 {
 				return nil, reSyntaxError(s, i, errors.New("opening //** without closing **//"))
 			}
-			p.words(s[start:i], "//** **//")
+			p.words(s[start:i], s[i:], "//** **//")
 			i += 4 + j + 4
 			start = i
 
@@ -292,13 +416,9 @@ func reParse(d *Dict, s string, strict bool) (*reSyntax, error) {
 		}
 	}
 
-	p.words(s[start:], "")
+	p.words(s[start:], "", "")
 	p.concat()
-	if p.swapVerticalBar() {
-		// pop vertical bar
-		p.stack = p.stack[:len(p.stack)-1]
-	}
-	p.alternate()
+	p.alternate(p.popVerticalBar())
 
 	n := len(p.stack)
 	if n != 1 {
@@ -329,18 +449,19 @@ func (p *reParser) push(re *reSyntax) *reSyntax {
 	return re
 }
 
-// words handles a block of words in the input.
-func (p *reParser) words(text, next string) {
-	words := p.dict.InsertSplit(text)
+// words handles a block of words in the input,
+// which is followed in the input by rest, starting with the operator next.
+func (p *reParser) words(text, rest, next string) {
+	words := p.dict.insertSplitFollowed(text, rest)
 	if len(words) == 0 {
 		return
 	}
 
-	// If the next operator is ??, we need to keep the last word
-	// separate, so that the ?? will only apply to that word.
+	// If the next operator is ?? or !!, we need to keep the last word
+	// separate, so that the operator will only apply to that word.
 	// There are no other operators that grab the last word.
 	var last Word
-	if next == "??" {
+	if next == "??" || next == "!!" {
 		words, last = words[:len(words)-1], words[len(words)-1]
 	}
 
@@ -359,35 +480,55 @@ func (p *reParser) words(text, next string) {
 	}
 
 	// Add the last word if needed.
-	if next == "??" {
+	if next == "??" || next == "!!" {
 		p.stack = append(p.stack, &reSyntax{op: opWords, w: []WordID{last.ID}})
 	}
 }
 
-// verticalBar handles a || in the input.
-func (p *reParser) verticalBar() error {
+// word adds the single word w to the parse,
+// as for a punctuation-sensitive word written [[w]].
+func (p *reParser) word(w WordID) {
+	if len(p.stack) > 0 && p.stack[len(p.stack)-1].op == opWords {
+		re := p.stack[len(p.stack)-1]
+		re.w = append(re.w, w)
+		return
+	}
+	p.push(&reSyntax{op: opWords, w: []WordID{w}})
+}
+
+// verticalBar handles a || or |> in the input, where bar is
+// opVerticalBar or opPriorityBar respectively.
+func (p *reParser) verticalBar(bar reOp) error {
 	p.concat()
 
 	// The concatenation we just parsed is on top of the stack.
 	// If it sits above an opVerticalBar, swap it below
 	// (things below an opVerticalBar become an alternation).
 	// Otherwise, push a new vertical bar.
+	if n := len(p.stack); n >= 2 && isBar(p.stack[n-2].op) && p.stack[n-2].op != bar {
+		return fmt.Errorf("|| and |> mixed in (( ))")
+	}
 	if !p.swapVerticalBar() {
-		p.push(&reSyntax{op: opVerticalBar})
+		p.push(&reSyntax{op: bar})
 	}
 
 	return nil
 }
 
+// isBar reports whether op is opVerticalBar or opPriorityBar.
+func isBar(op reOp) bool {
+	return op == opVerticalBar || op == opPriorityBar
+}
+
 // If the top of the stack is an element followed by an opVerticalBar
-// swapVerticalBar swaps the two and returns true.
+// or opPriorityBar, swapVerticalBar swaps the two and returns true.
 // Otherwise it returns false.
 func (p *reParser) swapVerticalBar() bool {
 	n := len(p.stack)
 	if n >= 2 {
 		re1 := p.stack[n-1]
 		re2 := p.stack[n-2]
-		if re2.op == opVerticalBar {
+		if isBar(re2.op) {
 			p.stack[n-2] = re1
 			p.stack[n-1] = re2
 			return true
@@ -396,14 +537,25 @@ func (p *reParser) swapVerticalBar() bool {
 	return false
 }
 
+// popVerticalBar pops the vertical bar, if any, from below the top
+// of the stack and returns the op for the alternation it separates:
+// opPriority for a |> bar and opAlternate otherwise.
+func (p *reParser) popVerticalBar() reOp {
+	if !p.swapVerticalBar() {
+		return opAlternate
+	}
+	bar := p.stack[len(p.stack)-1]
+	p.stack = p.stack[:len(p.stack)-1]
+	if bar.op == opPriorityBar {
+		return opPriority
+	}
+	return opAlternate
+}
+
 // rightParen handles a )) in the input.
 func (p *reParser) rightParen() error {
 	p.concat()
-	if p.swapVerticalBar() {
-		// pop vertical bar
-		p.stack = p.stack[:len(p.stack)-1]
-	}
-	p.alternate()
+	p.alternate(p.popVerticalBar())
 
 	n := len(p.stack)
 	if n < 2 {
@@ -434,11 +586,54 @@ func (p *reParser) quest() error {
 		// Repeated ?? don't accomplish anything new.
 		return nil
 	}
+	if sub.op == opRequired {
+		return fmt.Errorf("?? applied to required (( ))!!")
+	}
 
 	p.stack[n-1] = &reSyntax{op: opQuest, sub: []*reSyntax{sub}}
 	return nil
 }
 
+// required replaces the top stack element with itself marked required.
+func (p *reParser) required() error {
+	n := len(p.stack)
+	if n == 0 {
+		return fmt.Errorf("missing argument to !!")
+	}
+	sub := p.stack[n-1]
+	if sub.op >= opPseudo {
+		return fmt.Errorf("missing argument to !!")
+	}
+	if sub.op == opRequired {
+		// Repeated !! don't accomplish anything new.
+		return nil
+	}
+	if sub.op == opQuest {
+		return fmt.Errorf("!! applied to optional (( ))??")
+	}
+
+	p.stack[n-1] = &reSyntax{op: opRequired, sub: []*reSyntax{sub}}
+	return nil
+}
+
+// requiredPrefix returns the regexp syntax for the part of re
+// that runs through the end of its last required (( ))!! group,
+// or nil if re has no required groups.
+// Required groups appear only at the top level of re.
+func (re *reSyntax) requiredPrefix() *reSyntax {
+	switch re.op {
+	case opRequired:
+		return re
+	case opConcat:
+		for i := len(re.sub) - 1; i >= 0; i-- {
+			if re.sub[i].op == opRequired {
+				return &reSyntax{op: opConcat, sub: re.sub[:i+1]}
+			}
+		}
+	}
+	return nil
+}
+
 // concat replaces the top of the stack (above the topmost '||' or '((') with its concatenation.
 func (p *reParser) concat() *reSyntax {
 	// Scan down to find pseudo-operator || or ((.
@@ -457,8 +652,9 @@ func (p *reParser) concat() *reSyntax {
 	return p.push(p.collapse(opConcat, subs))
 }
 
-// alternate replaces the top of the stack (above the topmost '((') with its alternation.
-func (p *reParser) alternate() *reSyntax {
+// alternate replaces the top of the stack (above the topmost '((') with its alternation,
+// using op, which is opAlternate or opPriority.
+func (p *reParser) alternate(op reOp) *reSyntax {
 	// Scan down to find pseudo-operator ((.
 	// There are no || above ((.
 	i := len(p.stack)
@@ -468,7 +664,7 @@ func (p *reParser) alternate() *reSyntax {
 	subs := p.stack[i:]
 	p.stack = p.stack[:i]
 
-	return p.push(p.collapse(opAlternate, subs))
+	return p.push(p.collapse(op, subs))
 }
 
 // collapse returns the result of applying op to sub.
@@ -515,6 +711,9 @@ func (re *reSyntax) leadingPhrases() []phrase {
 		}
 		return []phrase{p}
 
+	case opRequired:
+		return re.sub[0].leadingPhrases()
+
 	case opQuest:
 		list := re.sub[0].leadingPhrases()
 		for _, l := range list {
@@ -525,7 +724,7 @@ func (re *reSyntax) leadingPhrases() []phrase {
 		list = append(list, phrase{BadWord, BadWord})
 		return list
 
-	case opAlternate:
+	case opAlternate, opPriority:
 		var list []phrase
 		have := make(map[phrase]bool)
 		for _, sub := range re.sub {
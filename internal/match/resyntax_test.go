@@ -26,6 +26,14 @@ var reParseTests = []struct {
 	{in: "z \n(( w ))\n(( a b c )) ??\n", out: "z w\n((a b c))??"},
 	{in: "(( a __123__ c )) ??", out: "((a __123__ c))??"},
 	{in: "a b ((c ||| d e)) f", out: "a b\n((c || d e))\nf"},
+	{in: "a b ((c |> d e |> f)) g", out: "a b\n((c |> d e |> f))\ng"},
+	{in: "a ((b |> ((c || d)))) e", out: "a\n((b |>\n((c || d))\n))\ne"},
+	{in: "a ((b |> c))?? d", out: "a\n((b |> c))??\nd"},
+	{in: "a [[C++]] b", out: "a [[c++]] b"},
+	{in: "version [[2.0]]", out: "version [[2.0]]"},
+	{in: "((the [[.NET]] || the))\nframework", out: "((the [[.net]] || the))\nframework"},
+	{in: "a\n((b c))!!\nd", out: "a\n((b c))!!\nd"},
+	{in: "a\n((b || c d))!!\ne", out: "a\n((b || c d))!!\ne"},
 }
 
 func TestReParse(t *testing.T) {
@@ -63,9 +71,19 @@ var reParseErrorTests = []struct {
 }{
 	{"a ((b))", "(( not at beginning of line"},
 	{"a || b", "|| outside (( ))"},
+	{"a |> b", "|> outside (( ))"},
+	{"((a || b |> c))", "|| and |> mixed in (( ))"},
+	{"((a |> b || c))", "|| and |> mixed in (( ))"},
 	{"((b)) c", ")) not at end of line"},
 	{"a??", "?? not preceded by ))"},
 	{"((a))\n??", "?? not preceded by ))"},
+	{"a!!", "!! not preceded by ))"},
+	{"((a))!! b", "!! not at end of line"},
+	{"((a\n((b))!!\n))", "!! inside (( ))"},
+	{"a [[C++ b", "opening [[ without closing ]]"},
+	{"a [[C ++]] b", "[[ ]] must enclose a single word without spaces"},
+	{"a [[]] b", "[[ ]] must enclose a single word without spaces"},
+	{"a [[++]] b", "[[++]] contains no words"},
 }
 
 func TestReParseError(t *testing.T) {
@@ -96,9 +114,11 @@ var phraseRETests = []struct {
 	{in: "(( a __123__ c )) ??", out: "[[] [a ?] [a c]]"},
 	{in: "a b ((c ||| d e)) f", out: "[[a b]]"},
 	{in: "((a || b)) ((c || d))", out: "[[a c] [a d] [b c] [b d]]"},
+	{in: "((a |> b)) ((c || d))", out: "[[a c] [a d] [b c] [b d]]"},
 	{in: "a?? b c", out: "[[a b] [b c]]"},
 	{in: "((a __1__))?? b c", out: "[[a ?] [a b] [b c]]"},
 	{in: "a __20__", out: "[[a ?] [a]]"},
+	{in: "((a b))!! c", out: "[[a b]]"},
 }
 
 func TestLeadingPhrases(t *testing.T) {
@@ -0,0 +1,145 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package match
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// sectionTag is the prefix of a section directive.
+const sectionTag = "<<section"
+
+// A section is a named section of an LRE, marked in the LRE by
+//
+//	<<section "name">>
+//
+// on a line by itself. The section runs to the next directive
+// or to the end of the LRE.
+type section struct {
+	name string
+	end  int // offset in the LRE text just past the section
+
+	once   sync.Once
+	prefix *LRE // the LRE up to end, compiled on first use
+}
+
+// parseSections returns s with its section directives replaced by spaces,
+// so that offsets in s are unchanged, along with the sections they mark.
+// Text before the first directive is not part of any section.
+// A directive must be on a line by itself and outside any (( )) group,
+// so that the text up to the end of each section is itself a valid LRE.
+func parseSections(s string) (string, []*section, error) {
+	if !strings.Contains(s, sectionTag) {
+		return s, nil, nil
+	}
+
+	var sections []*section
+	b := []byte(s)
+	depth := 0
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], "//**"):
+			j := strings.Index(s[i:], "**//")
+			if j < 0 {
+				return "", nil, reSyntaxError(s, i, errors.New("opening //** without closing **//"))
+			}
+			i += j + len("**//")
+			continue
+		case strings.HasPrefix(s[i:], "(("):
+			depth++
+			i += len("((")
+			continue
+		case strings.HasPrefix(s[i:], "))"):
+			depth--
+			i += len("))")
+			continue
+		case !strings.HasPrefix(s[i:], sectionTag):
+			i++
+			continue
+		}
+
+		k := strings.Index(s[i:], ">>")
+		if k < 0 {
+			return "", nil, reSyntaxError(s, i, errors.New("<<section without closing >>"))
+		}
+		k += i + len(">>")
+		if !atBOL(s, i) || !atEOL(s, k) {
+			return "", nil, reSyntaxError(s, i, errors.New("<<section not on a line by itself"))
+		}
+		if depth > 0 {
+			return "", nil, reSyntaxError(s, i, errors.New("<<section inside (( ))"))
+		}
+		arg := strings.TrimSpace(s[i+len(sectionTag) : k-len(">>")])
+		name, err := strconv.Unquote(arg)
+		if err != nil || name == "" || arg[0] != '"' {
+			return "", nil, reSyntaxError(s, i, errors.New("malformed "+s[i:k]))
+		}
+		if n := len(sections); n > 0 {
+			sections[n-1].end = i
+		}
+		sections = append(sections, &section{name: name, end: len(s)})
+		for j := i; j < k; j++ {
+			b[j] = ' '
+		}
+		i = k
+	}
+	return string(b), sections, nil
+}
+
+// Sections returns the names of the LRE's sections, in order.
+func (re *LRE) Sections() []string {
+	var names []string
+	for _, sec := range re.sections {
+		names = append(names, sec.name)
+	}
+	return names
+}
+
+// MatchSections returns the names of the LRE's sections that the start of
+// words, which are the result of re's Dict splitting text or a subslice of it,
+// matches in full: the longest list of leading sections such that
+// the LRE up to the end of the last of them matches the start of words.
+// It returns nil if the LRE has no sections or words match none of them.
+func (re *LRE) MatchSections(text string, words []Word) []string {
+	// The text matching through section i implies that it matches
+	// through every earlier section, so binary search for the last one.
+	n := 0
+	lo, hi := 0, len(re.sections)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if re.sections[mid].match(re, text, words) {
+			n = mid + 1
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	return re.Sections()[:n]
+}
+
+// match reports whether the start of words matches the LRE re
+// through the end of the section.
+func (sec *section) match(re *LRE, text string, words []Word) bool {
+	sec.once.Do(func() {
+		// The prefix is valid, since sections are outside any group,
+		// unless it ends in a wildcard; then the section never matches.
+		prefix, err := ParseLRE(re.dict, re.file, re.text[:sec.end])
+		if err == nil {
+			prefix.compile()
+			sec.prefix = prefix
+		}
+	})
+	if sec.prefix == nil {
+		return false
+	}
+	match, _, _ := sec.prefix.dfa.match(re.dict, text, words)
+	return match >= 0
+}
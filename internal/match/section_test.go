@@ -0,0 +1,71 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package match
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const sectionLRE = `Preface words here.
+<<section "1">>
+One: first section words.
+((maybe))??
+<<section "2">>
+	Two: second section words.
+<<section "3">>
+Three: third section words.
+`
+
+var matchSectionsTests = []struct {
+	in    string
+	names []string
+}{
+	{"Preface words here.", nil},
+	{"Preface words here. One: first section", nil},
+	{"Preface words here. One: first section words.", []string{"1"}},
+	{"Preface words here. One: first section words. Two: second", []string{"1"}},
+	{"Preface words here. One: first section words. maybe Two: second section words.", []string{"1", "2"}},
+	{"Preface words here. One: first section words. Two: second section words. Three: third section words.", []string{"1", "2", "3"}},
+	{"Something else.", nil},
+}
+
+func TestMatchSections(t *testing.T) {
+	var d Dict
+	re, err := ParseLRE(&d, "x", sectionLRE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := re.Sections(); !reflect.DeepEqual(names, []string{"1", "2", "3"}) {
+		t.Errorf("Sections() = %q, want [1 2 3]", names)
+	}
+	for _, tt := range matchSectionsTests {
+		if names := re.MatchSections(tt.in, d.Split(tt.in)); !reflect.DeepEqual(names, tt.names) {
+			t.Errorf("MatchSections(%q) = %q, want %q", tt.in, names, tt.names)
+		}
+	}
+}
+
+var sectionErrorTests = []struct {
+	in  string
+	err string
+}{
+	{"a\n<<section \"1\"\nb", "<<section without closing >>"},
+	{"a <<section \"1\">>\nb", "<<section not on a line by itself"},
+	{"a\n<<section 1>>\nb", "malformed <<section 1>>"},
+	{"a\n<<section \"\">>\nb", "malformed <<section \"\">>"},
+	{"a\n((\n<<section \"1\">>\nb\n))??", "<<section inside (( ))"},
+}
+
+func TestSectionErrors(t *testing.T) {
+	var d Dict
+	for _, tt := range sectionErrorTests {
+		_, err := ParseLRE(&d, "x", tt.in)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("ParseLRE(%q): error %v, want %q", tt.in, err, tt.err)
+		}
+	}
+}
@@ -23,16 +23,26 @@
 // Some matches report finding a known URL rather than complete license text.
 // (See licenses/README.md for details about the license set.)
 //
+// ScanSPDXTags is a much faster alternative for trees that mark every file
+// with an SPDX-License-Identifier tag: it reports the tagged license IDs
+// without matching any license text.
+//
 // A custom scanner can be created using NewScanner, passing in a set of license
 // patterns to scan for. The license patterns are written as license regular
 // expressions (LREs).
 // BuiltinLicenses returns the set of license patterns used by Scan.
+// Options, such as WithMaxMatches, can be passed to NewScanner
+// to adjust how the resulting scanner reports its results.
+// Several scanners recognizing different subsets of one license set
+// can share its parsed patterns by using NewDictionary and NewWithDictionary.
 //
 // License Regular Expressions
 //
 // Each license to be recognized is specified by writing a license regular
 // expression (LRE) for it. The pattern syntax and the matching are word-based and
 // case-insensitive; punctuation is ignored in the pattern and in the matched text.
+// So is the word "Section" or "Article" before the number of a heading,
+// so that headings numbered "1.", "1)", "(1)", or "Section 1." all match each other.
 //
 // The valid LRE patterns are:
 //
@@ -40,14 +50,18 @@
 //  - __N__, any sequence of up to N words
 //  - expr1 expr2, concatenation of two expressions
 //  - expr1 || expr2, alternation of two expressions
+//  - expr1 |> expr2, alternation of two expressions, preferring expr1
 //  - (( expr )), grouping
 //  - (( expr ))??, zero or one instances of the grouped expression
+//  - (( expr ))!!, the grouped expression, required in a partial match (see below)
 //  - //** text **//, a comment ignored by the parser
+//  - [[word]], a punctuation-sensitive word (see below)
 //
 // To make patterns harder to misread in large texts:
 // (( must only appear at the start of a line (possibly indented);
-// )) and ))?? must only appear at the end of a line (with possible trailing spaces);
-// and || must only appear inside a (( )) or (( ))?? group.
+// )), ))??, and ))!! must only appear at the end of a line (with possible trailing spaces);
+// || and |> must only appear inside a (( )) or (( ))?? group,
+// which cannot mix the two; and (( ))!! must not appear inside another group.
 //
 // For example:
 //
@@ -58,6 +72,46 @@
 // 	((men || women || people))
 // 	to come to the aid of their __1__.
 //
+// Alternation with || is symmetric: the overall match is the longest one
+// the alternatives and the rest of the pattern allow. Alternation with |>
+// gives the alternatives priority, in order: in ((A |> B)), once the text
+// has matched A, a match of B still in progress is abandoned, even if it
+// would have covered more of the text. See licenses/README.md for details.
+//
+// Punctuation is ignored by default, and a pattern should rarely need
+// anything else. For the rare name or version whose punctuation matters,
+// like "C++" as opposed to "C", a word written in the pattern as [[C++]]
+// matches only text that spells it with the same punctuation, ignoring case,
+// and that continues with no more letters or digits before the next space:
+// [[2.0]] matches "2.0" and "2.0," but not "2-0" or "2.0.1". Where the text
+// could follow either a [[word]] or the plain word, it follows the [[word]].
+//
+// A complete match must include all the text outside (( ))?? groups,
+// but a partial match, reported with WithMatchThreshold, or a match cut off
+// by the end of the input need not. Marking a group (( expr ))!! requires
+// those matches, too, to get past it: a partial or truncated match that stops
+// before the last such group in the LRE is not reported, however much of the
+// license it covers. Marking the sentences that set a license apart
+// from its relatives keeps their shared text, such as a warranty disclaimer,
+// from claiming it. See licenses/README.md for details.
+//
+// An LRE passed to NewScanner can also include the LRE of another license
+// in the same list, by ID, using a directive on a line by itself:
+//
+// 	<<include ID>>
+//
+// This lets a set of custom licenses share a common clause or header,
+// defined once as a license of its own. Included LREs can themselves
+// use <<include>>, but not in a cycle; NewScanner reports a cycle or
+// an include of an unknown ID as an error in the including license.
+//
+// An LRE can also mark the start of each named section of a license with
+//
+// 	<<section "name">>
+//
+// on a line by itself, outside any (( )) group.
+// Matches of the LRE then report the sections they include in Match.Sections.
+//
 // The old Cover and Checker API
 //
 // An older, less precise matcher using the names Cover, New, and Checker
@@ -83,10 +137,18 @@ import (
 // A License describes a single license that can be recognized.
 // At least one of LRE or URL should be set.
 type License struct {
-	ID   string // reported license ID
-	Type Type   // reported license type
-	LRE  string // license regular expression (see licenses/README.md)
-	URL  string // identifying URL
+	ID          string // reported license ID
+	Name        string // full license name, like "Apache License 2.0", if known
+	Type        Type   // reported license type
+	LRE         string // license regular expression (see licenses/README.md)
+	URL         string // identifying URL
+	IsNotice    bool   // LRE matches a short notice referring to the license, not its text
+	IsFragment  bool   // LRE matches only the opening of the license text, standing alone (see Match.IsFragment)
+	IsReference bool   // LRE matches a brief reference to the license by name, like "GPLv2"
+	IsGrant     bool   // LRE matches a prose statement granting the license, like "released under the MIT license"
+	OSIApproved bool   // license is approved by the Open Source Initiative (see Scanner.IsOSIApproved)
+	Lang        string // language of the LRE's text, like "de", if not English (see Match.Lang)
+	Text        string // canonical license text, supplied by the caller (see Scanner.CanonicalText)
 }
 
 // Coverage describes how the text matches various licenses.
@@ -100,18 +162,140 @@ type Coverage struct {
 	// but if the input text is a concatenation of licenses it will contain
 	// a match value for each element of the concatenation.
 	Match []Match
+
+	// Truncated reports whether Match was cut short by the limit
+	// set with WithMaxMatches. If so, Percent counts only the
+	// words covered by the matches that were kept.
+	Truncated bool
+
+	// InputWarnings describes problems with the input text that can make
+	// matches poorer than expected, such as mixed line endings or a byte
+	// order mark, for a Scanner using WithInputWarnings.
+	// It is nil if there are none, or if the Scanner does not look for them.
+	InputWarnings []string
+
+	text []byte // the scanned text, for NormalizedHash
 }
 
 // Match describes how a section of the input matches a license.
 // The ID field identifies the specific license. Its value is either an SPDX
 // identifier, or a locally created name for licenses that SPDX does not classify.
 // See licenses/README.md for more information.
+//
+// Start and End are byte offsets. RuneStart and RuneEnd give the same
+// locations counted in runes (Unicode code points) instead, for the benefit of
+// editors that index text that way. When counting runes, as in utf8.RuneCount,
+// each byte of an invalid UTF-8 sequence counts as a single rune.
+//
+// Complete reports that the match is of the full text of the license,
+// as opposed to a URL, tag, notice, reference, or grant naming the license.
+// Because a license's text only matches when all of it is present,
+// a complete match is unaffected by any unrelated text around it,
+// such as a PGP signature or a "Generated by" footer appended to
+// a license file: that text lowers the Coverage's Percent but not
+// the match's claim to be the whole license.
+//
+// TruncatedAtEnd reports that the input ended partway through the license,
+// as happens when reading only a prefix of a large file: the match runs to
+// the end of the input, but the rest of the license is missing, so the match
+// is not Complete. Reading more of the input and scanning again may find
+// the full license. Only the last match in a Coverage can be truncated.
+// Scanners using WithMatchThreshold also report partial matches anywhere
+// in the input; those are neither Complete nor TruncatedAtEnd.
+//
+// IsFragment reports that the match is of the opening of the license
+// standing alone, like the MIT license's "Permission is hereby granted,
+// free of charge, to any person obtaining a copy..." quoted in a file header.
+// A fragment match is also a notice, so IsNotice is set too.
+// Text that goes on to copy more of the license is not a fragment:
+// it matches the full license or, where it departs from it, nothing.
+//
+// CopyrightYears lists, in increasing order and without duplicates,
+// the years mentioned in the copyright lines at the start of the match,
+// such as "Copyright (c) 2015, 2019-2021 The Authors", with each range
+// expanded to the years it spans: here, 2015, 2019, 2020, and 2021.
+// It is nil if the match does not begin with a copyright line or if
+// those lines mention no years. Only four-digit years from 1900 to 2099 count,
+// apart from the end of an abbreviated range like 2019-21.
+//
+// Sections lists the sections of the license that the match includes,
+// for licenses whose regular expressions divide them into named sections,
+// such as the numbered sections of the GPL. A complete match includes
+// every section, and a match that is TruncatedAtEnd includes the leading
+// sections that the input contains in full: for a GPL-3.0 text cut off
+// partway through section 5, Sections lists the preamble and sections 0 to 4.
+// A partial match found using WithMatchThreshold lists sections the same way.
+// Sections is nil for a license without sections.
+//
+// UnfilledPlaceholders lists the template placeholders that the match
+// contains, such as the "[yyyy]" and "[name of copyright owner]" in
+// "Copyright [yyyy] [name of copyright owner]", or the "<organization>"
+// of a BSD license, left behind when a license was copied from a template
+// without filling it in. Placeholders are short phrases in brackets, like
+// [year] or <year>, or braces, like {year} or {{ cookiecutter.full_name }},
+// mentioning a year, date, name, owner, holder, author, organization,
+// company, or email address. Placeholders in the license's own text,
+// such as those in the GPL's instructions for applying it, are not listed.
+// UnfilledPlaceholders is nil if the match has no placeholders.
+//
+// Rider is the ID of a rider found next to the license, such as
+// CommonsClause, which adds conditions to another license: the license
+// text just before or after it. A rider materially changes the terms of
+// the license it modifies, so that an Apache-2.0 match with Rider set to
+// CommonsClause does not grant the right to sell the software. The rider
+// is also reported as a match of its own. Rider is empty if there is none.
+//
+// Lang is the language of the matched license text, as a BCP 47 tag such
+// as "de" or "fr", for a license written in a language other than English.
+// A license published in several languages, like the EUPL, is recognized
+// in each language by a separate LRE with its own Lang, so that in a file
+// holding several translations one after another, each match tells which
+// translation it is. Lang is empty for English text, and for a license
+// whose language is not recorded, such as one written in two languages
+// side by side.
+//
+// IsNoticeFile reports that the scanned text looks like an attribution
+// file, such as the NOTICE file that the Apache License asks a work to carry,
+// rather than a license: it opens with statements like “This product includes
+// software developed at ...” before any complete license text. Such files
+// name the licenses of the software a work bundles, and may quote them,
+// but they do not license the work itself, so a match in one is evidence
+// about the work's dependencies, not about its own license.
+// IsNoticeFile is set on every match of such a text or on none.
+//
+// Repeats is the number of consecutive identical copies of the license,
+// counting this one, that a Scanner using WithCollapseRepeats collapsed
+// into the match; text[LastStart:LastEnd] is the last of them.
+// Words counts the words of every copy, while Start and End (and the
+// rune offsets) give the location of the first.
+// Repeats is zero for a match that was not collapsed.
 type Match struct {
-	ID    string // License identifier.
-	Type  Type   // Set of license requirements.
-	Start int    // Start offset of match in text; match is at text[Start:End].
-	End   int    // End offset of match in text.
-	IsURL bool   // Whether match is a URL.
+	ID             string   // License identifier.
+	Type           Type     // Set of license requirements.
+	Start          int      // Start offset of match in text; match is at text[Start:End].
+	End            int      // End offset of match in text.
+	RuneStart      int      // Start offset of match in text, counted in runes (see above).
+	RuneEnd        int      // End offset of match in text, counted in runes.
+	Words          int      // Number of normalized words in text covered by the match.
+	Complete       bool     // Whether match covers the license's entire text (see above).
+	IsURL          bool     // Whether match is a URL.
+	IsTag          bool     // Whether match is an SPDX-License-Identifier tag (see ScanSPDXTags).
+	IsNotice       bool     // Whether match is a short notice referring to the license (see License.IsNotice).
+	IsFragment     bool     // Whether match is the opening of the license standing alone (see above).
+	IsReference    bool     // Whether match is a brief reference to the license by name (see License.IsReference).
+	IsGrant        bool     // Whether match is a prose statement granting the license (see License.IsGrant).
+	IsNoticeFile   bool     // Whether match is in an attribution file, like Apache's NOTICE, not a license (see above).
+	TruncatedAtEnd bool     // Whether input ended before the end of the license (see above).
+	CopyrightYears []int    // Years in the copyright lines starting the match (see above).
+	Sections       []string // Names of the license's sections that the match includes (see above).
+
+	UnfilledPlaceholders []string // Template placeholders, like [yyyy], left in the match (see above).
+	Rider                string   // ID of a rider, such as CommonsClause, modifying the license (see above).
+	Lang                 string   // Language of the matched text, like "fr", if not English (see above).
+	Repeats              int      // Number of identical copies collapsed into the match (see above).
+	LastStart, LastEnd   int      // Offsets of the last collapsed copy in text, if Repeats is set.
+
+	wordStart, wordEnd int // match covers normalized words [wordStart, wordEnd) of text, if set by Scan
 }
 
 // Type is a bit set describing the requirements imposed by a license or group of
@@ -156,6 +340,13 @@ const (
 	// making it difficult to comply with or vague about what it permits.
 	// Examples: Beerware, SISSL, WTFPL.
 	Discouraged
+
+	// NoGrant indicates that the text grants no rights at all:
+	// it states that the work is proprietary, that copying it is prohibited,
+	// or that no license is given, as opposed to there being no license text
+	// to find, which Scan reports as no match at all.
+	// Example: NoLicense.
+	NoGrant
 )
 
 // Merge returns the result of merging the requirements of license types t and u.
@@ -163,7 +354,7 @@ const (
 // If either is Unknown, the result is Unknown.
 // Among the bits Unrestricted, Notice, ShareChanges, ShareProgram, ShareServer,
 // the result will use the one that appears latest in the list and is present in either t or u.
-// The NonCommercial and Discouraged bits are set in the result if they are set in either t or u.
+// The NonCommercial, Discouraged, and NoGrant bits are set in the result if they are set in either t or u.
 func (t Type) Merge(u Type) Type {
 	if t == Unknown || u == Unknown {
 		return Unknown
@@ -176,11 +367,11 @@ func (t Type) Merge(u Type) Type {
 			break
 		}
 	}
-	m |= (t | u) & (NonCommercial | Discouraged)
+	m |= (t | u) & (NonCommercial | Discouraged | NoGrant)
 
-	// Special case: NonCommercial is a restriction,
+	// Special case: NonCommercial and NoGrant are restrictions,
 	// so drop the unrestricted bit if still set.
-	if m&Unrestricted != 0 && m&NonCommercial != 0 {
+	if m&Unrestricted != 0 && m&(NonCommercial|NoGrant) != 0 {
 		m &^= Unrestricted
 	}
 
@@ -199,6 +390,7 @@ var typeBits = []struct {
 	{ShareServer, "ShareServer"},
 	{NonCommercial, "NonCommercial"},
 	{Discouraged, "Discouraged"},
+	{NoGrant, "NoGrant"},
 }
 
 // String returns the type t in string form.
@@ -12,9 +12,12 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"text/tabwriter"
 
 	"github.com/google/licensecheck/internal/match"
 )
@@ -32,6 +35,14 @@ func TestTestdata(t *testing.T) {
 		t.Fatalf("no testdata files found")
 	}
 
+	// Once all the files have been checked, summarize any regressions.
+	var stats corpusStats
+	t.Cleanup(func() {
+		if t.Failed() || testing.Verbose() {
+			t.Logf("testdata summary:\n%s", stats.summary())
+		}
+	})
+
 	for _, file := range files {
 		name := filepath.Base(file)
 		if name == "README" {
@@ -67,8 +78,16 @@ func TestTestdata(t *testing.T) {
 				lineno++
 			}
 
+			scan := Scan
 			if len(hdr) > 0 && strings.HasPrefix(hdr[0], "set ") {
-				t.Fatalf("%s:%d: set not implemented", file, lineno)
+				switch opt := strings.TrimPrefix(hdr[0], "set "); opt {
+				default:
+					t.Fatalf("%s:%d: unknown set option %q", file, lineno, opt)
+				case "markdown":
+					scan = optionScanner(t, opt, WithMarkdown(true)).Scan
+				case "spelling":
+					scan = optionScanner(t, opt, WithSpellingVariants(true)).Scan
+				}
 				hdr = hdr[1:]
 				lineno++
 			}
@@ -97,10 +116,20 @@ func TestTestdata(t *testing.T) {
 						t.Fatalf("%s:%d: parsing want.Match[%d].Start,End: %v", file, lineno, i, err)
 					}
 					if len(f) == 3 {
-						if f[2] != "URL" {
-							t.Fatalf("%s:%d: field 2 should be omitted or should be 'URL'", file, lineno)
+						switch f[2] {
+						default:
+							t.Fatalf("%s:%d: field 2 should be omitted or should be 'URL', 'Notice', 'Reference', 'Grant', or 'Truncated'", file, lineno)
+						case "URL":
+							m.IsURL = true
+						case "Notice":
+							m.IsNotice = true
+						case "Reference":
+							m.IsReference = true
+						case "Grant":
+							m.IsGrant = true
+						case "Truncated":
+							m.TruncatedAtEnd = true
 						}
-						m.IsURL = true
 					}
 					want.Match = append(want.Match, m)
 					lineno++
@@ -111,7 +140,7 @@ func TestTestdata(t *testing.T) {
 			want := parseCoverage()
 			linenoEnd := lineno
 
-			cov := Scan(data)
+			cov := scan(data)
 			for _, m := range cov.Match {
 				typ := licenseType(m.ID)
 				if m.Type != typ {
@@ -133,20 +162,24 @@ func TestTestdata(t *testing.T) {
 				switch {
 				case len(covm) > 0 && (len(wantm) == 0 || covm[0].End < wantm[0].Start):
 					fmt.Fprintf(&buf, "+ %v\n", fmtMatch(covm[0], len(data)))
+					stats.add(covm[0].ID, 0, 1, 0)
 					covm = covm[1:]
 					mismatch = true
 
 				case len(covm) > 0 && len(wantm) > 0 && matchMatch(covm[0], wantm[0]):
 					fmt.Fprintf(&buf, "  %v\n", fmtMatch(covm[0], len(data)))
+					stats.add(covm[0].ID, 1, 0, 0)
 					covm = covm[1:]
 					wantm = wantm[1:]
 
 				default:
 					fmt.Fprintf(&buf, "- %v\n", fmtMatch(wantm[0], len(data)))
+					stats.add(wantm[0].ID, 0, 0, 1)
 					wantm = wantm[1:]
 					mismatch = true
 				}
 			}
+			stats.file(name, mismatch)
 			if mismatch {
 				t.Errorf("%s:%d,%d: diff -want +have:\n%s", file, linenoStart, linenoEnd, buf.Bytes())
 			}
@@ -154,6 +187,29 @@ func TestTestdata(t *testing.T) {
 	}
 }
 
+var (
+	optionScannersMu sync.Mutex
+	optionScanners   = make(map[string]*Scanner)
+)
+
+// optionScanner returns a Scanner for the builtin licenses using opt,
+// for test data files that say "set name".
+// The Scanner is built once per name and shared by all such files.
+func optionScanner(t *testing.T, name string, opt Option) *Scanner {
+	optionScannersMu.Lock()
+	defer optionScannersMu.Unlock()
+	s, ok := optionScanners[name]
+	if !ok {
+		var err error
+		s, err = NewScanner(BuiltinLicenses(), opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		optionScanners[name] = s
+	}
+	return s
+}
+
 // fmtMatch formats the match m for printing.
 func fmtMatch(m Match, end int) string {
 	// Special case for EOF end position.
@@ -167,6 +223,18 @@ func fmtMatch(m Match, end int) string {
 	if m.IsURL {
 		s += " URL"
 	}
+	if m.IsNotice {
+		s += " Notice"
+	}
+	if m.IsReference {
+		s += " Reference"
+	}
+	if m.IsGrant {
+		s += " Grant"
+	}
+	if m.TruncatedAtEnd {
+		s += " Truncated"
+	}
 	return s
 }
 
@@ -212,7 +280,96 @@ func matchMatch(have, want Match) bool {
 	return have.ID == want.ID &&
 		have.Start == want.Start &&
 		have.End == want.End &&
-		have.IsURL == want.IsURL
+		have.IsURL == want.IsURL &&
+		have.IsNotice == want.IsNotice &&
+		have.IsReference == want.IsReference &&
+		have.IsGrant == want.IsGrant &&
+		have.TruncatedAtEnd == want.TruncatedAtEnd
+}
+
+// corpusStats accumulates the results of TestTestdata,
+// counting for each license ID the expected matches that Scan found
+// (true positives), the matches Scan found that were not expected
+// (false positives), and the expected matches Scan missed (false negatives).
+// A match found with the wrong offsets or flags counts as both
+// a false positive and a false negative.
+type corpusStats struct {
+	mu     sync.Mutex
+	counts map[string]*[3]int // tp, fp, fn by license ID
+	files  int
+	failed []string
+}
+
+// add adds tp, fp, and fn to the counts for id.
+func (s *corpusStats) add(id string, tp, fp, fn int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.counts == nil {
+		s.counts = make(map[string]*[3]int)
+	}
+	c := s.counts[id]
+	if c == nil {
+		c = new([3]int)
+		s.counts[id] = c
+	}
+	c[0] += tp
+	c[1] += fp
+	c[2] += fn
+}
+
+// file records that the test data file name has been checked,
+// and whether its results differed from the expected ones.
+func (s *corpusStats) file(name string, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files++
+	if failed {
+		s.failed = append(s.failed, name)
+	}
+}
+
+// summary returns a table of the precision and recall of the
+// licenses with any unexpected or missing matches, followed by
+// the precision and recall over all licenses and the failing files.
+func (s *corpusStats) summary() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ratio := func(n, d int) float64 {
+		if d == 0 {
+			return 1
+		}
+		return float64(n) / float64(d)
+	}
+	var ids []string
+	for id := range s.counts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 8, 1, ' ', 0)
+	fmt.Fprintf(w, "license\ttp\tfp\tfn\tprecision\trecall\n")
+	var all [3]int
+	for _, id := range ids {
+		c := s.counts[id]
+		for i := range all {
+			all[i] += c[i]
+		}
+		if c[1] == 0 && c[2] == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%.3f\t%.3f\n", id, c[0], c[1], c[2], ratio(c[0], c[0]+c[1]), ratio(c[0], c[0]+c[2]))
+	}
+	fmt.Fprintf(w, "all\t%d\t%d\t%d\t%.3f\t%.3f\n", all[0], all[1], all[2], ratio(all[0], all[0]+all[1]), ratio(all[0], all[0]+all[2]))
+	w.Flush()
+
+	sort.Strings(s.failed)
+	fmt.Fprintf(&buf, "%d of %d files failed", len(s.failed), s.files)
+	if len(s.failed) > 0 {
+		fmt.Fprintf(&buf, ": %s", strings.Join(s.failed, " "))
+	}
+	buf.WriteString("\n")
+	return buf.String()
 }
 
 var benchdata []byte
@@ -244,6 +401,109 @@ func BenchmarkScanTestdata(b *testing.B) {
 	}
 }
 
+// BenchmarkScanSource scans this package's Go source files one at a time,
+// as when walking a source tree in which most files are not licenses.
+// The generated license data is excluded, since it is all license text.
+func BenchmarkScanSource(b *testing.B) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var texts [][]byte
+	size := 0
+	for _, file := range files {
+		if file == "data.gen.go" {
+			continue
+		}
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		texts = append(texts, data)
+		size += len(data)
+	}
+	Scan(nil) // build builtin scanner outside timing
+
+	b.SetBytes(int64(size))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, text := range texts {
+			Scan(text)
+		}
+	}
+}
+
+// BenchmarkRarityWeights scans the BSD, ISC, and MIT test data,
+// whose texts are easily confused with each other, with and without
+// WithRarityWeights. It reports the precision of treating a Percent
+// of at least 97 as meaning the input is a license text and nothing else,
+// as recorded by a want.Percent of 100 in the test data file.
+func BenchmarkRarityWeights(b *testing.B) {
+	var files []string
+	for _, pattern := range []string{"testdata/0BSD.*", "testdata/BSD-*", "testdata/ISC.*", "testdata/MIT.*"} {
+		list, err := filepath.Glob(pattern)
+		if err != nil {
+			b.Fatal(err)
+		}
+		files = append(files, list...)
+	}
+	var texts [][]byte
+	var exact []bool
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		i := bytes.Index(data, []byte("\n\n"))
+		if i < 0 {
+			b.Fatalf("%s: invalid test data file: no blank line terminating header", file)
+		}
+		hdr := strings.Split(string(data[:i]), "\n")
+		for len(hdr) > 0 && strings.HasPrefix(hdr[0], "#") {
+			hdr = hdr[1:]
+		}
+		if len(hdr) == 0 {
+			b.Fatalf("%s: header too short", file)
+		}
+		pct, err := parsePercent(hdr[0])
+		if err != nil {
+			b.Fatalf("%s: parsing want.Percent: %v", file, err)
+		}
+		texts = append(texts, data[i+2:])
+		exact = append(exact, pct == 100)
+	}
+
+	for _, weighted := range []bool{false, true} {
+		name := "raw"
+		if weighted {
+			name = "weighted"
+		}
+		b.Run(name, func(b *testing.B) {
+			s, err := NewScanner(BuiltinLicenses(), WithRarityWeights(weighted))
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ResetTimer()
+			tp, fp := 0, 0
+			for i := 0; i < b.N; i++ {
+				tp, fp = 0, 0
+				for j, text := range texts {
+					if s.Scan(text).Percent >= 97 {
+						if exact[j] {
+							tp++
+						} else {
+							fp++
+						}
+					}
+				}
+			}
+			if tp+fp > 0 {
+				b.ReportMetric(float64(tp)/float64(tp+fp), "precision")
+			}
+		})
+	}
+}
+
 var trace = flag.String("tr", "", "trace DFA execution on `file` in TestTrace")
 
 func TestTrace(t *testing.T) {
@@ -8,7 +8,7 @@
 //
 // Usage:
 //
-//	go run getspdx.go [-f] name...
+//	go run getspdx.go [-f] [-shared] name...
 //
 // Getspdx converts each JSON file into an LRE file id.lre, where id is the
 // "licenseId" filed in the JSON file. If the "isDeprecatedField" in a JSON file
@@ -21,6 +21,11 @@
 // If id.lre already exists, getspdx skips the conversion instead of overwriting id.lre.
 // If the -f flag is given, getspdx overwrites id.lre.
 //
+// If the -shared flag is given, getspdx replaces any text in the LRE that
+// matches one of the fragments shared by many licenses, such as the MIT
+// warranty disclaimer, with a {{template "name"}} call to the fragment's
+// existing definition (see the sharedFragments list below).
+//
 // As a special case, the name "all" means all non-deprecated SPDX licenses.
 //
 // Getcc expects to find the SPDX database checked out in _spdx,
@@ -56,10 +61,13 @@ type spdx struct {
 	IsOSIApproved           bool
 }
 
-var forceOverwrite = flag.Bool("f", false, "force overwrite")
+var (
+	forceOverwrite = flag.Bool("f", false, "force overwrite")
+	useShared      = flag.Bool("shared", false, "replace shared fragments with template calls")
+)
 
 func usage() {
-	fmt.Fprintf(os.Stderr, "usage: go run getspdx.go [-f] name\n")
+	fmt.Fprintf(os.Stderr, "usage: go run getspdx.go [-f] [-shared] name\n")
 	os.Exit(2)
 }
 
@@ -219,9 +227,17 @@ func convert(file string) {
 	for _, url := range info.SeeAlso {
 		fmt.Fprintf(&buf, "%s\n", url)
 	}
-	fmt.Fprintf(&buf, "**//\n\n")
+	fmt.Fprintf(&buf, "**//\n")
+	if info.IsOSIApproved {
+		fmt.Fprintf(&buf, "{{OSIApproved}}\n")
+	}
+	fmt.Fprintf(&buf, "\n")
 
-	buf.WriteString(templateToLRE(file, info.StandardLicenseTemplate))
+	lre := templateToLRE(file, info.StandardLicenseTemplate)
+	if *useShared {
+		lre = replaceShared(lre)
+	}
+	buf.WriteString(lre)
 
 	if exclude[id] {
 		return
@@ -409,6 +425,77 @@ NoCut:
 	return string(data)
 }
 
+// A sharedFragment is a passage common to many licenses,
+// defined once as a template in one of the .lre files.
+type sharedFragment struct {
+	name string         // template name
+	re   *regexp.Regexp // matches the passage in templateToLRE output
+}
+
+// sharedFragments lists the fragments that -shared replaces.
+// The text of each is written as plain words; a __ stands for
+// the name of the copyright holder or similar variable text.
+var sharedFragments = []sharedFragment{
+	{"mit-disclaimer", fragmentRE(`
+		THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+		EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+		MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+		IN NO EVENT SHALL __ BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+		LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE,
+		ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE
+		OR OTHER DEALINGS IN THE SOFTWARE.
+	`)},
+}
+
+// fragmentRE returns a regexp matching the words of text in LRE output,
+// separated by any punctuation, spacing, or line breaks.
+// Each __ in text matches an optional //** **// comment followed by
+// up to 5 words or wildcards, as allowed by the __5__ in mit-disclaimer.
+func fragmentRE(text string) *regexp.Regexp {
+	var parts []string
+	for _, f := range strings.Fields(text) {
+		if f == "__" {
+			parts = append(parts, `(?://\*\*.*?\*\*//\W+)?(?:\S+\W+){0,5}?`)
+			continue
+		}
+		for _, w := range words(f) {
+			parts = append(parts, regexp.QuoteMeta(w)+`\W+`)
+		}
+	}
+	re := strings.Join(parts, "")
+	re = strings.TrimSuffix(re, `\W+`)
+	return regexp.MustCompile(`(?is)\b` + re + `\b[[:punct:]]*`)
+}
+
+// replaceShared returns lre with each passage matching a shared fragment
+// replaced by a call to the fragment's template, on a line by itself.
+// Passages that overlap LRE groups are left alone,
+// since replacing them would leave the groups unbalanced.
+func replaceShared(lre string) string {
+	for _, f := range sharedFragments {
+		var buf strings.Builder
+		last := 0
+		for _, m := range f.re.FindAllStringIndex(lre, -1) {
+			s := lre[m[0]:m[1]]
+			if strings.Contains(s, "((") || strings.Contains(s, "))") || strings.Contains(s, "||") {
+				continue
+			}
+			buf.WriteString(lre[last:m[0]])
+			if m[0] > 0 && lre[m[0]-1] != '\n' {
+				buf.WriteString("\n")
+			}
+			fmt.Fprintf(&buf, "{{template %q}}", f.name)
+			if m[1] < len(lre) && lre[m[1]] != '\n' {
+				buf.WriteString("\n")
+			}
+			last = m[1]
+		}
+		buf.WriteString(lre[last:])
+		lre = buf.String()
+	}
+	return lre
+}
+
 func findAttr(tag, name string) string {
 	i := strings.Index(tag, name+`="`)
 	if i < 0 {
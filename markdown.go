@@ -0,0 +1,118 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import "bytes"
+
+// unwrapMarkdown returns text with its Markdown quoting removed,
+// as described in WithMarkdown, along with the offset in text
+// of each byte in the result. The offsets have one extra entry,
+// len(text), for the end of the result.
+func unwrapMarkdown(text []byte) (out []byte, pos []int) {
+	var fence []byte // opening fence of current code block, if any
+	for off := 0; off < len(text); {
+		end := len(text)
+		if i := bytes.IndexByte(text[off:], '\n'); i >= 0 {
+			end = off + i + 1
+		}
+		line := off
+
+		// Remove blockquote markers, which may nest.
+		for {
+			i := line + leadingSpaces(text[line:end], 3)
+			if i >= end || text[i] != '>' {
+				break
+			}
+			line = i + 1
+			if line < end && text[line] == ' ' {
+				line++
+			}
+		}
+
+		content := text[line+leadingSpaces(text[line:end], 3) : end]
+		switch {
+		case fence == nil && isFence(content):
+			fence = fenceMarker(content)
+			line = end - countNewline(text[off:end]) // keep only the newline
+		case fence != nil && isFence(content) && closesFence(content, fence):
+			fence = nil
+			line = end - countNewline(text[off:end]) // keep only the newline
+		case fence == nil:
+			// Remove the indentation of an indented code block.
+			if bytes.HasPrefix(text[line:end], []byte("    ")) {
+				line += 4
+			} else if line < end && text[line] == '\t' {
+				line++
+			}
+		}
+
+		for i := line; i < end; i++ {
+			out = append(out, text[i])
+			pos = append(pos, i)
+		}
+		off = end
+	}
+	pos = append(pos, len(text))
+	return out, pos
+}
+
+// leadingSpaces returns the number of spaces, at most max, at the start of b.
+func leadingSpaces(b []byte, max int) int {
+	n := 0
+	for n < len(b) && n < max && b[n] == ' ' {
+		n++
+	}
+	return n
+}
+
+// countNewline returns 1 if line ends in a newline and 0 otherwise.
+func countNewline(line []byte) int {
+	if len(line) > 0 && line[len(line)-1] == '\n' {
+		return 1
+	}
+	return 0
+}
+
+// isFence reports whether line, with any blockquote markers and
+// indentation removed, is a code fence: three or more backticks or tildes,
+// optionally followed by an info string such as a language name.
+func isFence(line []byte) bool {
+	return len(fenceMarker(line)) >= 3
+}
+
+// fenceMarker returns the run of backticks or tildes starting line.
+func fenceMarker(line []byte) []byte {
+	if len(line) == 0 || line[0] != '`' && line[0] != '~' {
+		return nil
+	}
+	n := 0
+	for n < len(line) && line[n] == line[0] {
+		n++
+	}
+	return line[:n]
+}
+
+// closesFence reports whether line closes the code block opened by fence:
+// it must use the same character, at least as many times, with nothing after it.
+func closesFence(line, fence []byte) bool {
+	m := fenceMarker(line)
+	return len(m) >= len(fence) && m[0] == fence[0] && len(bytes.TrimSpace(line[len(m):])) == 0
+}
+
+// remapMarkdown translates the offsets in c, which are for the result of
+// unwrapMarkdown(text), back to offsets in text, using pos.
+func (c *Coverage) remapMarkdown(text []byte, pos []int) {
+	for i := range c.Match {
+		m := &c.Match[i]
+		m.Start = pos[m.Start]
+		if m.End > 0 {
+			m.End = pos[m.End-1] + 1 // just after the last byte
+		} else {
+			m.End = pos[0]
+		}
+	}
+	setRuneOffsets(text, c.Match)
+	c.text = text
+}
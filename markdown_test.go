@@ -0,0 +1,43 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import "testing"
+
+var unwrapMarkdownTests = []struct {
+	in  string
+	out string
+}{
+	{"plain text\n", "plain text\n"},
+	{"> quoted\n> text\n", "quoted\ntext\n"},
+	{"> > nested\n>> tight\n>\n", "nested\ntight\n\n"},
+	{"```text\nfenced\n```\nafter\n", "\nfenced\n\nafter\n"},
+	{"~~~~\n```\nstill fenced\n~~~~\n", "\n```\nstill fenced\n\n"},
+	{"    indented\n\tcode\n", "indented\ncode\n"},
+	{"```\n    kept indent\n```\n", "\n    kept indent\n\n"},
+	{"> ```\n> fenced quote\n> ```\n", "\nfenced quote\n\n"},
+	{"a > b\n", "a > b\n"},
+	{"no newline", "no newline"},
+}
+
+func TestUnwrapMarkdown(t *testing.T) {
+	for _, tt := range unwrapMarkdownTests {
+		out, pos := unwrapMarkdown([]byte(tt.in))
+		if string(out) != tt.out {
+			t.Errorf("unwrapMarkdown(%q) = %q, want %q", tt.in, out, tt.out)
+			continue
+		}
+		if len(pos) != len(out)+1 || pos[len(out)] != len(tt.in) {
+			t.Errorf("unwrapMarkdown(%q): bad offsets %v", tt.in, pos)
+			continue
+		}
+		for i, c := range out {
+			if tt.in[pos[i]] != c {
+				t.Errorf("unwrapMarkdown(%q): out[%d] = %q but in[pos[%d]=%d] = %q", tt.in, i, c, i, pos[i], tt.in[pos[i]])
+				break
+			}
+		}
+	}
+}
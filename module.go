@@ -0,0 +1,87 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// moduleLicenseFiles lists the names of the license files
+// that ScanModule looks for, in the order it tries them.
+var moduleLicenseFiles = []string{
+	"LICENSE",
+	"LICENSE.md",
+	"LICENSE.txt",
+	"LICENCE",
+	"LICENCE.md",
+	"LICENCE.txt",
+	"COPYING",
+	"COPYING.md",
+	"COPYING.txt",
+}
+
+// ScanModule scans the license file at the root of the Go module in fsys
+// using the built-in license set, and returns its Coverage along with
+// the name of the file. See the ScanModule method for details.
+func ScanModule(fsys fs.FS) (Coverage, string, error) {
+	return builtinScanner.ScanModule(fsys)
+}
+
+// ScanModule scans the license file at the root of the module in fsys,
+// which has the module's go.mod at its root, and returns its Coverage
+// along with the name of the file.
+// It does not look in subdirectories: by the Go convention, a license file
+// there applies only to the packages in that directory and below.
+//
+// ScanModule looks for these file names, in this order, ignoring case
+// so that a file named License.md or copying is found too:
+// LICENSE, LICENSE.md, LICENSE.txt, LICENCE, LICENCE.md, LICENCE.txt,
+// COPYING, COPYING.md, and COPYING.txt.
+// It returns the first of them in which Scan finds a license, or,
+// if none of them contains a recognized license, the first of them,
+// whose Coverage then has no matches.
+// If fsys has none of the files, ScanModule returns an error.
+func (s *Scanner) ScanModule(fsys fs.FS) (Coverage, string, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return Coverage{}, "", fmt.Errorf("ScanModule: %w", err)
+	}
+	files := make(map[string]string) // actual file name, by upper-case name
+	for _, e := range entries {
+		if !e.IsDir() {
+			upper := strings.ToUpper(e.Name())
+			if _, ok := files[upper]; !ok {
+				files[upper] = e.Name()
+			}
+		}
+	}
+
+	var first Coverage
+	firstName := ""
+	for _, name := range moduleLicenseFiles {
+		file, ok := files[strings.ToUpper(name)]
+		if !ok {
+			continue
+		}
+		text, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return Coverage{}, "", fmt.Errorf("ScanModule: %w", err)
+		}
+		c := s.Scan(text)
+		if len(c.Match) > 0 {
+			return c, file, nil
+		}
+		if firstName == "" {
+			first, firstName = c, file
+		}
+	}
+	if firstName == "" {
+		return Coverage{}, "", errors.New("ScanModule: no license file")
+	}
+	return first, firstName, nil
+}
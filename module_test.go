@@ -0,0 +1,62 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+)
+
+func TestScanModule(t *testing.T) {
+	mit, err := os.ReadFile("testdata/MIT.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	apache, err := os.ReadFile("testdata/Apache-2.0.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	readme := []byte("This module does useful things.\n")
+	gomod := &fstest.MapFile{Data: []byte("module example.com/m\n")}
+
+	for _, tt := range []struct {
+		fsys fstest.MapFS
+		file string
+		id   string
+	}{
+		{fstest.MapFS{"go.mod": gomod, "LICENSE": {Data: mit}, "COPYING": {Data: apache}}, "LICENSE", "MIT"},
+		{fstest.MapFS{"go.mod": gomod, "License.md": {Data: apache}, "COPYING": {Data: mit}}, "License.md", "Apache-2.0"},
+		{fstest.MapFS{"go.mod": gomod, "copying": {Data: mit}, "sub/LICENSE": {Data: apache}}, "copying", "MIT"},
+
+		// A file without a license gives way to a later one with a license,
+		// but is reported if there is no other.
+		{fstest.MapFS{"go.mod": gomod, "LICENSE": {Data: readme}, "COPYING.txt": {Data: apache}}, "COPYING.txt", "Apache-2.0"},
+		{fstest.MapFS{"go.mod": gomod, "LICENSE": {Data: readme}}, "LICENSE", ""},
+	} {
+		c, file, err := ScanModule(tt.fsys)
+		if err != nil {
+			t.Errorf("ScanModule(%v): %v", tt.fsys, err)
+			continue
+		}
+		id := ""
+		if len(c.Match) > 0 {
+			id = c.Match[0].ID
+		}
+		if file != tt.file || id != tt.id {
+			t.Errorf("ScanModule(%v) = %s in %s, want %s in %s", tt.fsys, id, file, tt.id, tt.file)
+		}
+	}
+
+	for _, fsys := range []fstest.MapFS{
+		{"go.mod": gomod, "README": {Data: mit}},
+		{"go.mod": gomod, "sub/LICENSE": {Data: mit}},
+		{"go.mod": gomod, "LICENSE/MIT": {Data: mit}},
+	} {
+		if _, _, err := ScanModule(fsys); err == nil {
+			t.Errorf("ScanModule(%v) succeeded, want error", fsys)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"strings"
+
+	"github.com/google/licensecheck/internal/match"
+)
+
+// attributions are the phrases, in lower case, that open
+// the attribution statements making up a NOTICE file, like
+// “This product includes software developed at The Apache Software Foundation”
+// or “This product contains a modified version of ...”.
+var attributions = []string{
+	"this product bundles",
+	"this product contains",
+	"this product includes",
+	"this project incorporates components from",
+	"this software incorporates material from third parties",
+}
+
+// minLicenseWords is the number of words that a complete license match
+// must cover to count as the text of a license in setNoticeFile.
+// Shorter matches are license headers, like the Apache-2.0 boilerplate
+// “Licensed under the Apache License, Version 2.0 ...” that many NOTICE
+// files open with, not license files themselves.
+const minLicenseWords = 120
+
+// setNoticeFile sets the IsNoticeFile field of every match in list
+// if text, which has the given words, looks like an attribution file,
+// such as an Apache-style NOTICE file, instead of a license.
+// It does if an attribution statement comes before the first
+// complete license text, or if there is no complete license text.
+// An attribution inside or after a license text does not count:
+// the BSD-4-Clause license, for one, has the licensee repeat
+// “This product includes software developed by ...”, and many
+// LICENSE files append the notices of the software they bundle.
+func setNoticeFile(text []byte, words []match.Word, list []Match) {
+	if len(list) == 0 {
+		return
+	}
+	end := len(words)
+	for _, m := range list {
+		if m.Complete && m.Words >= minLicenseWords {
+			end = m.wordStart
+			break
+		}
+	}
+	if !hasAttribution(text, words[:end]) {
+		return
+	}
+	for i := range list {
+		list[i].IsNoticeFile = true
+	}
+}
+
+// hasAttribution reports whether the words of text
+// include one of the attributions.
+func hasAttribution(text []byte, words []match.Word) bool {
+	for _, a := range attributions {
+		phrase := strings.Fields(a)
+	Words:
+		for i := 0; i+len(phrase) <= len(words); i++ {
+			for j, p := range phrase {
+				w := words[i+j]
+				if !strings.EqualFold(string(text[w.Lo:w.Hi]), p) {
+					continue Words
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
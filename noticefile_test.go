@@ -0,0 +1,84 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var noticeFileTests = []struct {
+	name string
+	text string
+	want bool
+}{
+	{
+		"license then attributions",
+		license_MIT + "\nThis product includes software developed by the Gopher Project.\n",
+		false,
+	},
+	{
+		"attributions then license",
+		"This product includes software developed by the Gopher Project,\nused under the following license:\n\n" + license_MIT,
+		true,
+	},
+	{
+		"header then attributions",
+		"Copyright 2020 The Gopher Project\n\nLicensed under the Apache License, Version 2.0 (the \"License\");\n" +
+			"you may not use this file except in compliance with the License.\n" +
+			"You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0\n\n" +
+			"This product contains a modified version of the Gopher library.\n",
+		true,
+	},
+	{
+		"no attributions",
+		"Licensed under the Apache License, Version 2.0 (the \"License\");\n" +
+			"you may not use this file except in compliance with the License.\n",
+		false,
+	},
+}
+
+func TestNoticeFile(t *testing.T) {
+	for _, tt := range noticeFileTests {
+		c := Scan([]byte(tt.text))
+		if len(c.Match) == 0 {
+			t.Errorf("%s: Scan found no matches", tt.name)
+			continue
+		}
+		for _, m := range c.Match {
+			if m.IsNoticeFile != tt.want {
+				t.Errorf("%s: match %s has IsNoticeFile=%v, want %v", tt.name, m.ID, m.IsNoticeFile, tt.want)
+			}
+		}
+	}
+}
+
+// TestNoticeFileTestdata checks that Scan sets IsNoticeFile
+// on the matches in the test data files holding NOTICE files,
+// and on no others.
+func TestNoticeFileTestdata(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.t*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i := bytes.Index(data, []byte("\n\n")); i >= 0 {
+			data = data[i+2:]
+		}
+		want := strings.HasPrefix(filepath.Base(file), "NOTICE.")
+		for _, m := range Scan(data).Match {
+			if m.IsNoticeFile != want {
+				t.Errorf("%s: match %s has IsNoticeFile=%v, want %v", file, m.ID, m.IsNoticeFile, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+// An Option configures optional behavior of a Scanner.
+// Options are passed to NewScanner.
+type Option func(*options)
+
+// options holds the optional configuration of a Scanner.
+// The zero value is the default behavior.
+type options struct {
+	maxMatches  int // maximum number of matches to report; 0 means no limit
+	maxTokenLen int // maximum token length in input; 0 means no limit
+
+	looseNumbers bool // match any number against any other number
+	spelling     bool // match British and American spellings against each other
+
+	threshold float64 // minimum percent of an LRE a partial match must cover; 0 means DefaultMatchThreshold
+
+	headerWords    int  // scan only the first headerWords words of input; 0 means scan it all
+	headerFallback bool // scan all the input if the header has no license match
+	endsWords      int  // scan only the first and last endsWords words of input; 0 means scan it all
+
+	rarityWeights bool // weight words in Percent by their rarity in the license set
+	uniqueWords   bool // compute Scanner.Coverage over distinct words, not positions
+
+	markdown bool // remove Markdown code fences and blockquote markers before scanning
+
+	collapseRepeats bool // report consecutive identical matches as one, with Match.Repeats set
+
+	normTrace func(orig, normalized string) // if non-nil, called for each word Scan reads
+
+	inputWarnings bool // set Coverage.InputWarnings
+
+	onError func(id string, err error) // if non-nil, called for each license skipped by NewScanner
+}
+
+// WithMaxMatches limits the number of matches reported by Scan to n.
+// If a scan finds more than n matches, only the n matches covering
+// the most words of the input are kept (in text order), the Coverage's
+// Truncated field is set, and Percent describes only the kept matches.
+// This bounds the size of the result for degenerate inputs,
+// such as dictionaries, that match many short license fragments.
+// Scan discards the smaller matches as it goes, so it never holds
+// more than n matches, plus any license URLs between them, in memory.
+// A limit of n <= 0 means no limit, which is the default.
+func WithMaxMatches(n int) Option {
+	return func(o *options) {
+		if n < 0 {
+			n = 0
+		}
+		o.maxMatches = n
+	}
+}
+
+// WithMaxTokenLength limits the length of the tokens in the input,
+// where a token is a run of bytes not containing ASCII spacing.
+// If the input to Scan contains a token longer than n bytes,
+// such as a base64 blob or a line of minified JavaScript,
+// Scan treats the entire input as not a license and returns an empty Coverage
+// without further work. This protects servers scanning arbitrary files.
+// A limit of n <= 0 means no limit, which is the default.
+func WithMaxTokenLength(n int) Option {
+	return func(o *options) {
+		if n < 0 {
+			n = 0
+		}
+		o.maxTokenLen = n
+	}
+}
+
+// WithOnError makes NewScanner skip licenses whose LRE cannot be parsed,
+// instead of failing. For each skipped license, NewScanner calls f
+// with the license ID and the parse error, a *ParseError,
+// and continues with the rest.
+// The resulting Scanner recognizes only the valid licenses.
+// This is useful when loading license sets of varying quality
+// from external sources.
+func WithOnError(f func(id string, err error)) Option {
+	return func(o *options) {
+		o.onError = f
+	}
+}
+
+// WithLooseNumbers controls whether numbers must match literally.
+// By default, a number in a license regular expression, such as a section
+// or version number, matches only the same number in the input.
+// If loose is true, any number in the input matches any number in the LRE,
+// so that licenses whose clauses have been renumbered still match.
+// This applies to the LREs and the input alike.
+// Version numbers then no longer distinguish licenses:
+// for example, notices for version 2 and version 3 of a license become
+// indistinguishable, and the Scanner reports whichever is listed first.
+func WithLooseNumbers(loose bool) Option {
+	return func(o *options) {
+		o.looseNumbers = loose
+	}
+}
+
+// DefaultMatchThreshold is the match threshold that a Scanner uses
+// unless it is changed with WithMatchThreshold: the text must match
+// a license's entire regular expression, except at the end of the input
+// (see Match.TruncatedAtEnd).
+const DefaultMatchThreshold = 100
+
+// WithMatchThreshold sets the percentage of a license's text that input
+// must follow for Scan to report a match. By default, the input must match
+// a license in full, apart from the variations that the license's regular
+// expression allows, so that text departing from a license partway through,
+// such as a license with an added or rewritten clause, goes unmatched
+// from the start of the license up to that point.
+// With a threshold below DefaultMatchThreshold, Scan also reports
+// such partial matches when the matching words make up at least
+// percent percent of the shortest text the license's regular expression
+// allows, and at least ten words. A partial match has Complete and
+// TruncatedAtEnd both false, and it counts toward the Coverage's
+// Percent like any other match.
+//
+// A partial match covers only the text before the point of departure,
+// so the phrases that a complete match requires, even the clauses that
+// set a license apart from its relatives, can go unmatched. Only the
+// groups that a license's regular expression marks as required, (( ))!!,
+// must be matched in a partial match too.
+//
+// For example, WithMatchThreshold(40) reports a copy of a license that follows
+// the license for its first half and then departs from it. Low thresholds
+// make coincidental matches of common legal phrases more likely.
+// Searching for partial matches also makes Scan considerably slower.
+// A percent outside the range (0, 100] selects DefaultMatchThreshold.
+func WithMatchThreshold(percent float64) Option {
+	return func(o *options) {
+		if percent <= 0 || percent > DefaultMatchThreshold {
+			percent = 0
+		}
+		o.threshold = percent
+	}
+}
+
+// WithSpellingVariants controls whether British and American spellings
+// of the same word match each other. By default, a word must be spelled
+// as in the license regular expression, up to a one-letter typo.
+// If fold is true, the British spellings in a closed list are read
+// as their American counterparts, in the LREs and the input alike,
+// so that a "licence"-spelled copy of a license matches
+// a "license"-spelled LRE. The list is:
+//
+//	acknowledgement(s), authorisation, authorise(d), behaviour,
+//	centre, defence, favour, fulfil, judgement, licence(s), licenced,
+//	licencing, licencor(s), modelling, offence, organisation(s),
+//	practise, programme(s), recognise(d), utilise
+//
+// It is limited to words that appear in license texts and
+// cannot be mistaken for other words.
+func WithSpellingVariants(fold bool) Option {
+	return func(o *options) {
+		o.spelling = fold
+	}
+}
+
+// WithHeaderScan limits Scan to the start of the input, where source files
+// keep their license headers: Scan looks only at the first maxWords words
+// of the input, rounded up to the end of the line holding the last of them,
+// and ignores the rest. Without the rest of the input, the Coverage's Percent
+// is the percentage of that header window, not of the whole input, covered
+// by matches. For large source files with small headers, this is much faster
+// than scanning the whole file.
+//
+// By default, nothing beyond the window is scanned, even if the window has
+// no license text match. See WithHeaderFallback to change that.
+// A limit of maxWords <= 0 means no limit, which is the default.
+func WithHeaderScan(maxWords int) Option {
+	return func(o *options) {
+		if maxWords < 0 {
+			maxWords = 0
+		}
+		o.headerWords = maxWords
+	}
+}
+
+// WithHeaderFallback controls what Scan does when the header window
+// set by WithHeaderScan contains no match of license text
+// (a URL alone does not count). If fallback is true, Scan then scans
+// the entire input, as though WithHeaderScan had not been used,
+// so that only files with a license header are scanned quickly.
+// If fallback is false, the default, Scan returns what it found in the window.
+func WithHeaderFallback(fallback bool) Option {
+	return func(o *options) {
+		o.headerFallback = fallback
+	}
+}
+
+// WithEndsOnly limits Scan to the two ends of the input, where files keep
+// their license text, for a fast first pass over many large files.
+// Scan first looks at the first n words of the input, rounded up to the end
+// of the line holding the last of them, as for WithHeaderScan. If that finds
+// a match of license text (a URL alone does not count), Scan returns it
+// without reading further. Otherwise Scan looks at the last n words of the
+// input, rounded back to the start of the line holding the first of them,
+// and returns what it finds there. The Coverage's Percent is the percentage
+// of the window it describes, not of the whole input, covered by matches.
+// An input of at most 2*n words is scanned in full.
+//
+// WithEndsOnly trades completeness for speed: Scan does not see any
+// license in the middle of the input, such as a license quoted in a README
+// between other sections or the second of several concatenated licenses,
+// nor the rest of a license that starts in the first window.
+// It takes precedence over WithHeaderScan and WithHeaderFallback.
+// A limit of n <= 0 means no limit, which is the default.
+func WithEndsOnly(n int) Option {
+	return func(o *options) {
+		if n < 0 {
+			n = 0
+		}
+		o.endsWords = n
+	}
+}
+
+// WithRarityWeights controls how Scan computes a Coverage's Percent.
+// By default, Percent counts every normalized word of the input equally.
+// If weighted is true, each word instead counts in proportion to how
+// distinctive it is among the Scanner's licenses, so that text shared by many
+// licenses, such as a warranty disclaimer, contributes less to Percent
+// than the same number of words found in only a few licenses, or in none.
+//
+// A word's weight is 1 + ln((1+N)/(1+df)), where N is the number of license
+// regular expressions in the Scanner and df is the number of them that
+// contain the word: the smoothed inverse document frequency of the word
+// across the license set. Words in no license, including all words of
+// unrelated text, get the largest weight, 1 + ln(1+N).
+// Percent is then the total weight of the words covered by matches,
+// as a percentage of the total weight of all the words in the input.
+//
+// Only Percent changes; the matches themselves, and their Words counts,
+// are the same with or without weighting.
+func WithRarityWeights(weighted bool) Option {
+	return func(o *options) {
+		o.rarityWeights = weighted
+	}
+}
+
+// WithUniqueWords controls how Scanner.Coverage scores a text
+// against a single license. By default, Coverage reports the percentage
+// of the text's words, counted by position, covered by matches of the
+// license, so a copy that omits any required part of the license text,
+// even a repetition of a clause that appears elsewhere in it, may not
+// match at all.
+//
+// If unique is true, Coverage instead compares the sets of distinct words
+// in the text and in the license, ignoring order and repetition: it reports
+// the number of distinct words found in both, as a percentage of the number
+// of distinct words found in either. An abridged copy of a license that
+// drops a repeated clause thus still scores close to 100%, while extra text
+// and missing words both lower the score. Words that appear only in
+// alternatives or optional parts of the license count as missing when the
+// text leaves them out, so even a faithful copy may score somewhat below 100%.
+//
+// Because it ignores word order, this mode can score a text highly
+// that does not read as the license at all; it is meant for judging
+// candidates already believed to be copies of the license, not for
+// identifying licenses. Scan is unaffected.
+func WithUniqueWords(unique bool) Option {
+	return func(o *options) {
+		o.uniqueWords = unique
+	}
+}
+
+// WithMarkdown controls whether Scan treats its input as Markdown,
+// as when scanning a README that quotes a license.
+// If markdown is true, Scan removes the Markdown markup that quotes text
+// before matching it: the lines opening and closing fenced code blocks,
+// along with any info string such as "text" in "```text";
+// the ">" markers of blockquotes, including nested ones like "> >";
+// and the four-space or tab indentation of indented code blocks.
+// The offsets in the resulting Coverage are still for the original input,
+// so a match of a fenced license starts and ends inside the fence.
+// Other Markdown is left alone: Scan ignores most punctuation anyway.
+func WithMarkdown(markdown bool) Option {
+	return func(o *options) {
+		o.markdown = markdown
+	}
+}
+
+// WithCollapseRepeats controls whether Scan reports consecutive identical
+// matches of a license as a single match, as for a generated or bundled file
+// that repeats the same license header for each of its parts.
+// If collapse is true, each run of adjacent matches of the same license
+// whose matched text is the same, after the normalization described in
+// Coverage.NormalizedHash, is reported as its first match, with
+// Match.Repeats set to the number of copies in the run and Match.LastStart
+// and Match.LastEnd giving the location of the last copy.
+// The matched text includes any copyright lines at the start of a match,
+// so copies naming different copyright holders are not identical and
+// are still reported separately, each with the attribution it requires.
+// Percent is unchanged: it still counts the words of every copy.
+func WithCollapseRepeats(collapse bool) Option {
+	return func(o *options) {
+		o.collapseRepeats = collapse
+	}
+}
+
+// WithNormalizationTrace sets a function for Scan to call with each word
+// of its input as it reads it, for debugging a license that does not match
+// as expected. For each word, in order, Scan calls f with the word's text
+// in the input and its normalized form, the one it matches against the
+// license regular expressions: for example, "Copies" and "copy",
+// or "(c)" and "copyright". Punctuation, markup, and the other text that
+// normalization drops, like the "Section" in "Section 1. Definitions",
+// are not words and are not reported. The normalized forms are those of
+// Scanner.Tokenize; they reflect WithLooseNumbers and WithSpellingVariants.
+//
+// Scan calls f once for each pass it makes over the text, so with
+// WithHeaderFallback a file without a license header is reported twice:
+// first its header window and then all of it. With WithMarkdown,
+// the words are those left after the Markdown markup is removed.
+// Tracing makes Scan slower; without it, the default, Scan does no extra work.
+func WithNormalizationTrace(f func(orig, normalized string)) Option {
+	return func(o *options) {
+		o.normTrace = f
+	}
+}
+
+// WithInputWarnings controls whether Scan checks its input for problems
+// that can make matches poorer than expected, such as those left behind
+// by converting a file between systems or extracting it from a PDF,
+// and describes any it finds in the Coverage's InputWarnings.
+// The warnings, in this order, are:
+//
+//	"byte order mark": the input begins with a UTF-8 or UTF-16 byte order mark
+//	"mixed line endings": some lines end in \r\n and others in \n alone
+//	"stray carriage returns": a \r is not followed by \n
+//	"N% of bytes are not text": more than 10% of the input is control
+//	    characters other than spacing, or invalid UTF-8, as for a binary file
+//	    or text in UTF-16
+//
+// The checks are a single pass over the input and do not affect the matches.
+// By default, Scan does not check, and InputWarnings is always nil.
+func WithInputWarnings(warn bool) Option {
+	return func(o *options) {
+		o.inputWarnings = warn
+	}
+}
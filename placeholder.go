@@ -0,0 +1,52 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// placeholderRE matches text that may be a template placeholder:
+	// a short phrase in square or angle brackets, like [yyyy] or <year>,
+	// or in braces, like {year}, ${year}, or {{ cookiecutter.full_name }}.
+	placeholderRE = regexp.MustCompile(`\[[\w .'-]{1,40}\]|<[\w .'-]{1,40}>|\{\{ *[\w.'-][\w .'-]{0,39}\}\}|\$?\{[\w .'-]{1,40}\}`)
+
+	// placeholderWordRE matches the words that mark a placeholderRE match
+	// as a placeholder for the details of a copyright notice.
+	placeholderWordRE = regexp.MustCompile(`(?i)\b(?:yyyy|years?|date|(?:full_?|author_|project_|your_?)?name|owners?|holders?|authors?|organi[sz]ation|company|e-?mail)\b`)
+)
+
+// unfilledPlaceholders returns the placeholders, in order of first
+// appearance and without duplicates, in the match of the i'th LRE
+// at text[start:end], which begins with copyright lines up to lreStart.
+// Placeholders in the copyright lines always count. Placeholders later
+// in the match count only if the license's LRE does not spell them out,
+// as the GPL's does in its instructions for applying the license:
+// a placeholder there is part of the license text, not a field left unfilled.
+func (s *Scanner) unfilledPlaceholders(text []byte, i, start, lreStart, end int) []string {
+	var list []string
+	var lre string
+	seen := make(map[string]bool)
+	for _, loc := range placeholderRE.FindAllIndex(text[start:end], -1) {
+		lo, hi := start+loc[0], start+loc[1]
+		p := string(text[lo:hi])
+		if seen[p] || !placeholderWordRE.MatchString(p) {
+			continue
+		}
+		if lo >= lreStart {
+			if lre == "" {
+				lre = strings.ToLower(s.licenses[i].LRE)
+			}
+			if strings.Contains(lre, strings.ToLower(p)) {
+				continue
+			}
+		}
+		seen[p] = true
+		list = append(list, p)
+	}
+	return list
+}
@@ -0,0 +1,75 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import "bytes"
+
+// licenseIndicators are the word prefixes that LooksLikeLicense looks for,
+// in lower case. Nearly every license text, notice, reference, and URL
+// contains at least one of them: even the shortest licenses tend to say
+// that the work can be distributed or used freely.
+var licenseIndicators = [][]byte{
+	[]byte("agpl"),
+	[]byte("copr"),
+	[]byte("copyleft"),
+	[]byte("copyright"),
+	[]byte("distribut"),
+	[]byte("freely"),
+	[]byte("gpl"),
+	[]byte("lgpl"),
+	[]byte("licenc"),
+	[]byte("licens"),
+	[]byte("permission"),
+	[]byte("permit"),
+	[]byte("redistribut"),
+	[]byte("unlicens"),
+	[]byte("warrant"),
+}
+
+// LooksLikeLicense reports whether text plausibly contains a license,
+// as a cheap test to run before Scan when most of the inputs,
+// such as the files in a large source tree, contain none.
+// It reports true if text contains any word beginning with one of
+// a few words that nearly all licenses use, such as “copyright”,
+// “distribute”, “license”, “permission”, and “warranty”,
+// or a license family name like “GPL”, or a © sign.
+// Because a single such word is enough, LooksLikeLicense reports true
+// for many texts in which Scan finds no license: it is a prefilter,
+// and its false positives only cost the Scan that follows.
+// A license in which none of the words appear, perhaps one
+// passed to NewScanner, can be missed.
+//
+// LooksLikeLicense reports false for text that Scan ignores
+// because of WithMaxTokenLength.
+func (s *Scanner) LooksLikeLicense(text []byte) bool {
+	if s.opts.maxTokenLen > 0 && hasLongToken(text, s.opts.maxTokenLen) {
+		return false
+	}
+	for i := 0; i < len(text); {
+		if !isASCIILetter(text[i]) {
+			if bytes.HasPrefix(text[i:], []byte("©")) {
+				return true
+			}
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(text) && isASCIILetter(text[j]) {
+			j++
+		}
+		for _, w := range licenseIndicators {
+			if j-i >= len(w) && bytes.EqualFold(text[i:i+len(w)], w) {
+				return true
+			}
+		}
+		i = j
+	}
+	return false
+}
+
+// isASCIILetter reports whether c is an ASCII letter.
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var looksLikeLicenseTests = []struct {
+	text string
+	want bool
+}{
+	{"", false},
+	{"package main\n\nfunc main() {\n\tprintln(\"hello, world\")\n}\n", false},
+	{"// Copyright 2020 The Go Authors. All rights reserved.\n", true},
+	{"SPDX-License-Identifier: MIT", true},
+	{"Released under the LICENCE in the root directory.", true},
+	{"THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND", true},
+	{"© 2020 Someone", true},
+	{"Copr. 2020 Someone", true},
+	{"see http://www.apache.org/licenses/LICENSE-2.0", true},
+	{"unlicensed", true},
+	{"This library is distributed under the terms of LGPLv2.1", true},
+	{"the warren of rabbits", false},
+}
+
+func TestLooksLikeLicense(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT"})
+	for _, tt := range looksLikeLicenseTests {
+		if got := s.LooksLikeLicense([]byte(tt.text)); got != tt.want {
+			t.Errorf("LooksLikeLicense(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+
+	long := newTestScanner(t, []string{license_MIT}, WithMaxTokenLength(10))
+	if long.LooksLikeLicense([]byte("Copyright " + strings.Repeat("x", 11))) {
+		t.Errorf("LooksLikeLicense with long token = true, want false")
+	}
+}
+
+// looksLikeLicenseMisses lists the test data files holding
+// licenses that use none of the licenseIndicators.
+var looksLikeLicenseMisses = map[string]bool{
+	"diffmark.t1": true, // "you can do what you want with it"
+}
+
+// TestLooksLikeLicenseTestdata checks that LooksLikeLicense
+// reports true for every test data file in which Scan finds a license.
+func TestLooksLikeLicenseTestdata(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.t*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := builtinScanner
+	for _, file := range files {
+		if looksLikeLicenseMisses[filepath.Base(file)] {
+			continue
+		}
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i := bytes.Index(data, []byte("\n\n")); i >= 0 {
+			data = data[i+2:]
+		}
+		if c := s.Scan(data); len(c.Match) > 0 && !s.LooksLikeLicense(data) {
+			t.Errorf("%s: LooksLikeLicense = false, but Scan found %s", file, c.Match[0].ID)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/licensecheck/internal/match"
+)
+
+// ReplaceLicense replaces the LRE of one of the Scanner's licenses with l.LRE,
+// for tools that edit an LRE and show how the Scanner's matches of some
+// test input change after each edit. The replaced license is the one
+// with l's ID that is of the same kind as l: a license text, or a notice,
+// fragment, reference, or grant (see License.IsNotice and the fields following it).
+// Its other fields, such as Name, Type, and Lang, are replaced by l's too.
+//
+// ReplaceLicense parses only the new LRE, reusing the parsed LREs of
+// the Scanner's other licenses, but it rebuilds the state machine that
+// matches all of them together, so each call costs about as much as
+// that part of NewScanner. For the built-in licenses, that is most
+// of a second; for a Scanner holding only the license under edit
+// and any licenses it might be confused with, it is much less.
+// Scans after ReplaceLicense returns see the new LRE.
+//
+// ReplaceLicense returns an error if the Scanner has no such license,
+// if the Scanner was created by NewWithDictionary,
+// or, as a *ParseError, if the new LRE is invalid.
+// In those cases the Scanner is unchanged, down to the words in its dictionary.
+//
+// Unlike the Scanner's other methods, ReplaceLicense must not be called
+// while other goroutines are using the Scanner.
+func (s *Scanner) ReplaceLicense(l License) error {
+	s.initBuiltin()
+	if s.shared {
+		return errors.New("ReplaceLicense: Scanner shares a Dictionary")
+	}
+	i := s.licenseIndex(l)
+	if i < 0 {
+		return fmt.Errorf("ReplaceLicense: unknown license %s", l.ID)
+	}
+
+	includes := includeLookup(s.licenses)
+	lre, err := match.ExpandIncludes(l.ID, l.LRE, includes)
+	if err != nil {
+		return parseError(l.ID, l.LRE, includes, err)
+	}
+	l.LRE = lre
+
+	// Parsing adds the LRE's new words to the dictionary shared with
+	// the other licenses; remove them again if the LRE proves unusable.
+	dict := s.re.Dict()
+	n := len(dict.Words())
+	re, err := match.ParseLRE(dict, l.ID, l.LRE)
+	if err != nil {
+		dict.Truncate(n)
+		return parseError(l.ID, l.LRE, includes, err)
+	}
+	if l.IsFragment {
+		re.SetFragment()
+	}
+
+	list := append([]*match.LRE(nil), s.lres...)
+	list[i] = re
+	if err := s.compile(list, includes); err != nil {
+		dict.Truncate(n)
+		return err
+	}
+	s.licenses[i] = l
+	s.lres = list
+	s.addInfo(l)
+	if l.Name != "" {
+		s.names[l.ID] = l.Name
+	}
+	if s.opts.rarityWeights {
+		s.weights = rarityWeights(s.re.Dict(), list)
+	}
+
+	// Forget what was derived from the old LRE.
+	s.singleMu.Lock()
+	delete(s.single, l.ID)
+	s.singleMu.Unlock()
+	s.evidenceOnce = sync.Once{}
+	s.evidence = nil
+	return nil
+}
+
+// licenseIndex returns the index in s.licenses of the license
+// with l's ID and of the same kind as l, or -1 if there is none.
+func (s *Scanner) licenseIndex(l License) int {
+	for i, x := range s.licenses {
+		if x.ID == l.ID && x.IsNotice == l.IsNotice && x.IsFragment == l.IsFragment &&
+			x.IsReference == l.IsReference && x.IsGrant == l.IsGrant {
+			return i
+		}
+	}
+	return -1
+}
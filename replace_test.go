@@ -0,0 +1,85 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/licensecheck/internal/match"
+)
+
+func TestReplaceLicense(t *testing.T) {
+	s, err := NewScanner([]License{
+		{ID: "Fox", LRE: "The quick brown fox jumps over the lazy dog."},
+		{ID: "Fox", IsNotice: true, LRE: "See the Fox license for details."},
+		{ID: "Lorem", LRE: "Lorem ipsum dolor sit amet, consectetur adipiscing elit."},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := []byte("The quick brown fox leaps over the lazy dog.\nSee the Fox license for details.\n")
+	ids := func() []string {
+		var list []string
+		for _, m := range s.Scan(text).Match {
+			list = append(list, m.ID)
+		}
+		return list
+	}
+	if have := ids(); len(have) != 1 {
+		t.Fatalf("before ReplaceLicense: matches = %v, want only the notice", have)
+	}
+
+	err = s.ReplaceLicense(License{ID: "Fox", Name: "Fox License", LRE: "The quick brown fox\n((jumps || leaps))\nover the lazy dog."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := s.Scan(text)
+	if len(c.Match) != 2 || c.Match[0].ID != "Fox" || !c.Match[0].Complete || !c.Match[1].IsNotice || c.Percent != 100 {
+		t.Errorf("after ReplaceLicense: Scan = %.1f%% %+v, want Fox text and notice", c.Percent, c.Match)
+	}
+	if name := s.LicenseName("Fox"); name != "Fox License" {
+		t.Errorf("LicenseName(Fox) = %q, want %q", name, "Fox License")
+	}
+	if _, ok := s.Coverage(text, "Fox"); !ok {
+		t.Errorf("Coverage(Fox) found no license after ReplaceLicense")
+	}
+
+	if err := s.ReplaceLicense(License{ID: "Fox", IsGrant: true, LRE: "released under the Fox license"}); err == nil {
+		t.Errorf("ReplaceLicense(unknown grant) succeeded, want error")
+	}
+	if err := s.ReplaceLicense(License{ID: "Dog", LRE: "woof woof"}); err == nil {
+		t.Errorf("ReplaceLicense(unknown license) succeeded, want error")
+	}
+	var pe *ParseError
+	if err := s.ReplaceLicense(License{ID: "Fox", LRE: "((missing close"}); !errors.As(err, &pe) || pe.LicenseID != "Fox" {
+		t.Errorf("ReplaceLicense(invalid LRE) = %v, want *ParseError for Fox", err)
+	}
+	if have := ids(); len(have) != 2 {
+		t.Errorf("after failed ReplaceLicense: matches = %v, want Fox text and notice", have)
+	}
+
+	// A failed call leaves no new words behind in the dictionary.
+	words := len(s.re.Dict().Words())
+	for _, lre := range []string{"Zebra [[yak]] ((xylophone", "__5__ zebra yak xylophone"} {
+		if err := s.ReplaceLicense(License{ID: "Fox", LRE: lre}); err == nil {
+			t.Errorf("ReplaceLicense(%q) succeeded, want error", lre)
+		}
+		if n := len(s.re.Dict().Words()); n != words {
+			t.Errorf("after failed ReplaceLicense(%q): dictionary has %d words, want %d", lre, n, words)
+		}
+		if id := s.re.Dict().Lookup("zebra"); id != match.BadWord {
+			t.Errorf("after failed ReplaceLicense(%q): Lookup(zebra) = %d, want BadWord", lre, id)
+		}
+	}
+
+	d, err := NewWithDictionary(NewDictionary(BuiltinLicenses()), []string{"MIT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.ReplaceLicense(License{ID: "MIT", LRE: "Permission is granted to do anything"}); err == nil {
+		t.Errorf("ReplaceLicense on Scanner from NewWithDictionary succeeded, want error")
+	}
+}
@@ -8,9 +8,14 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"unicode/utf8"
 
 	"github.com/google/licensecheck/internal/match"
 )
@@ -49,41 +54,132 @@ func BuiltinLicenses() []License {
 type Scanner struct {
 	licenses []License
 	urls     map[string]License
+	textsMu  sync.RWMutex
+	texts    map[string]string // canonical texts, by license ID; guarded by textsMu
+	names    map[string]string // full license names, by license ID
+	osi      map[string]bool   // OSI-approved license IDs
 	re       *match.MultiLRE
+	opts     options
+	weights  []float64 // rarity weight of each word, by WordID, for WithRarityWeights
+
+	singleMu sync.Mutex
+	single   map[string]*Scanner // single-license Scanners used by Coverage, by license ID
+
+	evidenceOnce sync.Once
+	evidence     map[wordPair]int // number of license IDs using each pair of words, for Evidence
+
+	detectorsMu sync.RWMutex
+	detectors   []regexpDetector // added by AddRegexp; guarded by detectorsMu
+
+	lres   []*match.LRE // lres[i] is the parsed LRE of licenses[i], for ReplaceLicense
+	shared bool         // s shares a Dictionary's word list (see NewWithDictionary)
 }
 
 // NewScanner returns a new Scanner that recognizes the given set of licenses.
 // See the description of Scan more information.
-func NewScanner(licenses []License) (*Scanner, error) {
+// The options, if any, adjust the Scanner's behavior; see Option.
+func NewScanner(licenses []License, opts ...Option) (*Scanner, error) {
 	s := new(Scanner)
-	err := s.init(licenses)
+	for _, opt := range opts {
+		opt(&s.opts)
+	}
+	err := s.init(licenses, nil)
 	if err != nil {
 		return nil, err
 	}
 	return s, nil
 }
 
-func (s *Scanner) init(licenses []License) error {
+// init initializes s to recognize the licenses.
+// If files is not nil, files[i] is the file that licenses[i] was read from,
+// for reporting parse errors (see NewFromFS).
+func (s *Scanner) init(licenses []License, files []string) error {
 	d := new(match.Dict)
+	if s.opts.looseNumbers {
+		d.FoldNumbers()
+	}
+	if s.opts.spelling {
+		d.FoldSpelling()
+	}
 	d.Insert("copyright")
 	d.Insert("http")
 	var list []*match.LRE
 	s.urls = make(map[string]License)
-	for _, l := range licenses {
-		if l.URL != "" {
-			s.urls[l.URL] = l
-		}
+	s.texts = make(map[string]string)
+	s.names = make(map[string]string)
+	s.osi = make(map[string]bool)
+	includes := includeLookup(licenses)
+	for i, l := range licenses {
+		s.addInfo(l)
 		if l.LRE != "" {
-			s.licenses = append(s.licenses, l)
+			lre, err := match.ExpandIncludes(l.ID, l.LRE, includes)
+			if err != nil {
+				err := parseError(l.ID, l.LRE, includes, err)
+				if files != nil {
+					err.File = files[i]
+				}
+				if s.opts.onError == nil {
+					return err
+				}
+				s.opts.onError(l.ID, err)
+				continue
+			}
+			l.LRE = lre
 			re, err := match.ParseLRE(d, l.ID, l.LRE)
 			if err != nil {
-				return fmt.Errorf("parsing %v: %v", l.ID, err)
+				err := parseError(l.ID, l.LRE, includes, err)
+				if files != nil {
+					err.File = files[i]
+				}
+				if s.opts.onError == nil {
+					return err
+				}
+				s.opts.onError(l.ID, err)
+				continue
+			}
+			if l.IsFragment {
+				re.SetFragment()
 			}
+			s.licenses = append(s.licenses, l)
 			list = append(list, re)
 		}
 	}
+	if err := s.compile(list, includes); err != nil {
+		return err
+	}
+	s.lres = list
+	if s.opts.rarityWeights {
+		s.weights = rarityWeights(d, list)
+	}
+	return nil
+}
+
+// addInfo records the canonical text, name, OSI approval, and URL
+// of the license l in s.
+func (s *Scanner) addInfo(l License) {
+	if l.Text != "" {
+		s.texts[l.ID] = l.Text
+	}
+	if _, ok := s.names[l.ID]; !ok && l.Name != "" {
+		s.names[l.ID] = l.Name
+	}
+	if l.OSIApproved {
+		s.osi[l.ID] = true
+	}
+	if l.URL != "" {
+		s.urls[l.URL] = l
+	}
+}
+
+// compile sets s.re to match list, the parsed LREs of s.licenses.
+// The includes function looks up included LREs, for reporting errors.
+func (s *Scanner) compile(list []*match.LRE, includes func(string) (string, bool)) error {
 	re, err := match.NewMultiLRE(list)
 	if err != nil {
+		var se *match.SyntaxError
+		if errors.As(err, &se) && se.File != "" {
+			return parseError(se.File, "", includes, err)
+		}
 		return err
 	}
 	if re == nil {
@@ -93,8 +189,431 @@ func (s *Scanner) init(licenses []License) error {
 	return nil
 }
 
+// rarityWeights returns the weight of each word in d, indexed by WordID,
+// for the list of LREs, as described in WithRarityWeights.
+func rarityWeights(d *match.Dict, list []*match.LRE) []float64 {
+	df := make([]int, len(d.Words()))
+	for _, re := range list {
+		for _, w := range re.Words() {
+			if w >= 0 && int(w) < len(df) {
+				df[w]++
+			}
+		}
+	}
+	n := float64(len(list))
+	weights := make([]float64, len(df))
+	for w, k := range df {
+		weights[w] = 1 + math.Log((1+n)/(1+float64(k)))
+	}
+	return weights
+}
+
+// weightedPercent returns the percentage of the total rarity weight
+// of words that is covered by the matches in list.
+func (s *Scanner) weightedPercent(words []match.Word, list []Match) float64 {
+	unknown := 1 + math.Log(1+float64(len(s.licenses))) // df = 0
+	weight := func(w match.Word) float64 {
+		if w.ID >= 0 && int(w.ID) < len(s.weights) {
+			return s.weights[w.ID]
+		}
+		return unknown
+	}
+	total, covered := 0.0, 0.0
+	for _, w := range words {
+		total += weight(w)
+	}
+	for _, m := range list {
+		for _, w := range words[m.wordStart:m.wordEnd] {
+			covered += weight(w)
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return 100 * covered / total
+}
+
+// initBuiltin initializes s if it is the built-in scanner
+// and has not been initialized yet.
+func (s *Scanner) initBuiltin() {
+	if s == builtinScanner {
+		builtinScannerOnce.Do(func() {
+			if err := builtinScanner.init(BuiltinLicenses(), nil); err != nil {
+				panic("licensecheck: initializing Scan: " + err.Error())
+			}
+		})
+	}
+}
+
+// WriteDOT writes the state machine compiled from the Scanner's licenses
+// to w in Graphviz DOT format. Each accepting state is labeled with
+// the ID of the license it matches, and each transition is labeled
+// with the words that lead to the next state, with * standing for any word.
+// WriteDOT is meant for debugging license sets, such as
+// finding out why two LREs collide; the output for a large set
+// of licenses is very large.
+func (s *Scanner) WriteDOT(w io.Writer) error {
+	s.initBuiltin()
+	return s.re.WriteDOT(w, func(i int) string { return s.licenses[i].ID })
+}
+
+// CanonicalText returns the canonical text of the license with the given ID,
+// as supplied by the caller in the Text field of the License passed
+// to NewScanner or by SetCanonicalText, so that callers can compare
+// a copy of a license against the original.
+// The Scanner has no canonical texts of its own: BuiltinLicenses
+// leaves Text empty. If the caller has supplied no text for the license,
+// CanonicalText returns "", false.
+func (s *Scanner) CanonicalText(id string) (string, bool) {
+	s.initBuiltin()
+	s.textsMu.RLock()
+	defer s.textsMu.RUnlock()
+	text, ok := s.texts[id]
+	return text, ok
+}
+
+// SetCanonicalText sets the canonical text that CanonicalText returns
+// for the license with the given ID, replacing any text recorded
+// in the License passed to NewScanner, so that callers can compare
+// copies of a license against their own approved wording.
+// SetCanonicalText affects only CanonicalText and the comparisons
+// made with it, not which licenses Scan matches.
+// It returns an error if the Scanner has no license with the given ID.
+func (s *Scanner) SetCanonicalText(id, text string) error {
+	s.initBuiltin()
+	if !s.hasID(id) {
+		return fmt.Errorf("SetCanonicalText: unknown license %s", id)
+	}
+	s.textsMu.Lock()
+	defer s.textsMu.Unlock()
+	s.texts[id] = text
+	return nil
+}
+
+// hasID reports whether any License passed to NewScanner had the given ID.
+func (s *Scanner) hasID(id string) bool {
+	for _, l := range s.licenses {
+		if l.ID == id {
+			return true
+		}
+	}
+	for _, l := range s.urls {
+		if l.ID == id {
+			return true
+		}
+	}
+	s.textsMu.RLock()
+	defer s.textsMu.RUnlock()
+	_, ok := s.texts[id]
+	return ok
+}
+
+// LicenseName returns the full name of the license with the given ID,
+// such as "Apache License 2.0" for "Apache-2.0", for display to users.
+// The name is taken from the Name field of the first License
+// passed to NewScanner with that ID and a non-empty Name.
+// If the Scanner knows no name for the license, LicenseName returns "".
+func (s *Scanner) LicenseName(id string) string {
+	s.initBuiltin()
+	return s.names[id]
+}
+
+// IsOSIApproved reports whether the license with the given ID is approved
+// by the Open Source Initiative, as recorded in the OSIApproved field
+// of any License passed to NewScanner with that ID.
+// For the built-in licenses, the flag is taken from the SPDX license list.
+// The IDs that licensecheck defines for license texts without a choice
+// of version, such as GPL-2.0 (see licenses/README.md), are approved
+// when all the SPDX licenses they could stand for are.
+func (s *Scanner) IsOSIApproved(id string) bool {
+	s.initBuiltin()
+	return s.osi[id]
+}
+
+// Coverage returns the percentage of text, in normalized words,
+// that matches the license with the given ID, ignoring all other licenses.
+// It is meant for scoring how faithful a copy of a known license is:
+// unlike Scan, no other license can claim part of the text.
+// The ok result reports whether the Scanner has a license regular expression
+// for the ID at all; if not, Coverage returns 0, false.
+func (s *Scanner) Coverage(text []byte, id string) (percent float64, ok bool) {
+	sub, err := s.singleScanner(id)
+	if sub == nil || err != nil {
+		return 0, false
+	}
+	if s.opts.uniqueWords {
+		return sub.uniqueCoverage(text), true
+	}
+	return sub.Scan(text).Percent, true
+}
+
+// uniqueCoverage returns the percentage of the distinct words found in
+// text or in s's licenses that are found in both, as described in WithUniqueWords.
+// Words not in s's dictionary are distinguished by their lowercased text.
+func (s *Scanner) uniqueCoverage(text []byte) float64 {
+	d := s.re.Dict()
+	lre := make(map[match.WordID]bool)
+	for _, w := range s.re.Words() {
+		lre[w] = true
+	}
+	known := make(map[match.WordID]bool)
+	unknown := make(map[string]bool)
+	for _, w := range d.Split(string(text)) {
+		if w.ID == match.BadWord {
+			unknown[strings.ToLower(string(text[w.Lo:w.Hi]))] = true
+		} else {
+			known[w.ID] = true
+		}
+	}
+	both, either := 0, len(lre)+len(unknown)
+	for w := range known {
+		if lre[w] {
+			both++
+		} else {
+			either++
+		}
+	}
+	if either == 0 {
+		return 0
+	}
+	return 100 * float64(both) / float64(either)
+}
+
+// sameLicensePercent is the share of a text, as a percentage, that its
+// dominant license must cover for SameLicense to consider it.
+const sameLicensePercent = 75
+
+// SameLicense reports whether a and b carry the same license,
+// as when deciding whether two LICENSE files are duplicates.
+// It scans both texts and compares their dominant licenses, as returned by
+// Coverage.Dominant: the texts carry the same license if the dominant
+// licenses have the same ID and each covers at least 75% of its text.
+// The comparison is by ID alone, so a copy of a license with a different
+// copyright line or formatting, or a short notice naming the license,
+// carries the same license as the full text; but two licenses of the same
+// Type, such as MIT and BSD-2-Clause, do not.
+// Two texts with no dominant license, or too little of one, never carry
+// the same license.
+func (s *Scanner) SameLicense(a, b []byte) bool {
+	ida, pa := s.Scan(a).Dominant()
+	idb, pb := s.Scan(b).Dominant()
+	return ida != "" && ida == idb && pa >= sameLicensePercent && pb >= sameLicensePercent
+}
+
+// Candidates returns the sorted IDs of the licenses that the prefix
+// could be the beginning of, as when giving feedback on a license
+// as it is being typed or pasted. A license is a candidate if the
+// prefix matches its text so far: Candidates skips any text before the
+// first place a license can start, such as a title or copyright line,
+// and requires the rest of the prefix, through its last word, to be
+// consistent with the license's regular expression. A license whose text
+// the prefix already contains in full is still a candidate, since
+// the text may go on, but one the prefix has gone past is not.
+// Notices, references, and grants naming a license make it
+// a candidate as well as the license text does.
+//
+// If no license can start anywhere in the prefix, Candidates returns nil.
+// It also returns nil once the prefix has diverged from every license:
+// adding more text to a prefix can remove candidates but never add them
+// back, except by starting a new license later in the text.
+func (s *Scanner) Candidates(prefix []byte) []string {
+	s.initBuiltin()
+	var ids []string
+	seen := make(map[string]bool)
+	for _, i := range s.re.Candidates(string(prefix)) {
+		if id := s.licenses[i].ID; !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// WordRange returns the minimum and maximum number of normalized words
+// in a match of the license with the given ID, as when choosing how much
+// of a stream to read before scanning it. Optional and alternative text
+// in the license, and wildcards for names and dates, make the two differ.
+// If the Scanner has several license regular expressions for the ID,
+// such as one for the license text and one for a short notice,
+// WordRange reports the range over all of them.
+// The ok result reports whether the Scanner has a license regular expression
+// for the ID at all; if not, WordRange returns 0, 0, false.
+//
+// The counts are for the words of the license regular expressions.
+// Spelling correction, such as joining a word hyphenated across a line break,
+// can make a match of the same text a word longer or shorter in places,
+// and a match can also be extended to absorb copyright lines before it.
+func (s *Scanner) WordRange(id string) (min, max int, ok bool) {
+	s.initBuiltin()
+	for i, l := range s.licenses {
+		if l.ID != id {
+			continue
+		}
+		lo, hi := s.re.LRE(i).WordRange()
+		if !ok || lo < min {
+			min = lo
+		}
+		if hi > max {
+			max = hi
+		}
+		ok = true
+	}
+	return min, max, ok
+}
+
+// includeLookup returns a function reporting the LRE that an
+// <<include id>> directive in one of the licenses refers to:
+// the LRE of the first license with that ID matching the license text,
+// or else the first with that ID matching a notice, reference, or grant.
+func includeLookup(licenses []License) func(id string) (string, bool) {
+	return func(id string) (string, bool) {
+		lre := ""
+		for _, l := range licenses {
+			if l.ID != id || l.LRE == "" {
+				continue
+			}
+			if !l.IsNotice && !l.IsReference && !l.IsGrant {
+				return l.LRE, true
+			}
+			if lre == "" {
+				lre = l.LRE
+			}
+		}
+		return lre, lre != ""
+	}
+}
+
+// singleScanner returns a Scanner for just the license with the given ID,
+// or nil if s has no LRE for that ID.
+func (s *Scanner) singleScanner(id string) (*Scanner, error) {
+	s.initBuiltin()
+	s.singleMu.Lock()
+	defer s.singleMu.Unlock()
+	if sub, ok := s.single[id]; ok {
+		return sub, nil
+	}
+
+	var list []License
+	for _, l := range s.licenses {
+		if l.ID == id {
+			list = append(list, l)
+		}
+	}
+	var sub *Scanner
+	if len(list) > 0 {
+		for _, l := range s.urls {
+			if l.ID == id && l.LRE == "" {
+				list = append(list, l)
+			}
+		}
+		sub = &Scanner{opts: s.opts}
+		if err := sub.init(list, nil); err != nil {
+			return nil, err
+		}
+	}
+	if s.single == nil {
+		s.single = make(map[string]*Scanner)
+	}
+	s.single[id] = sub
+	return sub, nil
+}
+
 const maxCopyrightWords = 50
 
+// copyrightStart returns the index of the word starting the block of
+// copyright notices that precedes words[start], or start itself if there is none.
+// The block is found by repeatedly looking back at most maxCopyrightWords
+// for the earliest "copyright" word, so that a stack of several copyright
+// lines ahead of a license is absorbed into the match.
+// The search does not go back past words[lastEnd].
+func copyrightStart(words []match.Word, lastEnd, start int, copyright match.WordID) int {
+	for {
+		limit := start - maxCopyrightWords
+		if limit < lastEnd {
+			limit = lastEnd
+		}
+		i := limit
+		for i < start && words[i].ID != copyright {
+			i++
+		}
+		if i == start {
+			return start
+		}
+		start = i
+	}
+}
+
+// maxCopyrightRange is the longest year range that copyrightYears expands.
+// A longer one is more likely a pair of unrelated numbers than a range.
+const maxCopyrightRange = 100
+
+// copyrightYearRE matches a year, or a range of years, in a copyright line.
+var copyrightYearRE = regexp.MustCompile(`\b((?:19|20)[0-9][0-9])(?:\s*(?:-|–|—|to)\s*((?:19|20)?[0-9][0-9]))?\b`)
+
+// copyrightYears returns the sorted, distinct years mentioned in the
+// copyright lines at the start of the match of words[start:end],
+// as described in the Match documentation.
+// The copyright lines are those from the start of the match up to the
+// first later line that has words but no "copyright", or up to the end
+// of the match.
+func copyrightYears(text []byte, words []match.Word, start, end int, copyright match.WordID) []int {
+	if copyright < 0 || start >= end || words[start].ID != copyright {
+		return nil
+	}
+	lo, hi := int(words[start].Lo), int(words[end-1].Hi)
+	for i := start; i < end; {
+		// Words [i, j) are on the same line.
+		j := i + 1
+		for j < end && !bytes.Contains(text[words[j-1].Hi:words[j].Lo], []byte("\n")) {
+			j++
+		}
+		has := false
+		for _, w := range words[i:j] {
+			if w.ID == copyright {
+				has = true
+			}
+		}
+		if !has {
+			break
+		}
+		hi = int(words[j-1].Hi)
+		i = j
+	}
+
+	seen := make(map[int]bool)
+	var years []int
+	add := func(y int) {
+		if !seen[y] {
+			seen[y] = true
+			years = append(years, y)
+		}
+	}
+	for _, m := range copyrightYearRE.FindAllSubmatch(text[lo:hi], -1) {
+		first, _ := strconv.Atoi(string(m[1]))
+		last := first
+		if len(m[2]) == 2 {
+			last, _ = strconv.Atoi(string(m[1][:2]) + string(m[2]))
+		} else if len(m[2]) == 4 {
+			last, _ = strconv.Atoi(string(m[2]))
+		}
+		if last < first || last-first > maxCopyrightRange {
+			// Not a range after all: keep the first year only,
+			// plus the second if it is a year on its own.
+			add(first)
+			if len(m[2]) == 4 {
+				add(last)
+			}
+			continue
+		}
+		for y := first; y <= last; y++ {
+			add(y)
+		}
+	}
+	sort.Ints(years)
+	return years
+}
+
 // Scan computes the coverage of the text according to the license set compiled
 // into the package. The design aims never to give a false positive.
 //
@@ -120,15 +639,232 @@ var urlScanRE = regexp.MustCompile(`^(?i)https?://[-a-z0-9_.]+\.(org|com)(/[-a-z
 // Scan is like the top-level function Scan,
 // but it uses the set of licenses in the Scanner instead of the built-in license set.
 func (s *Scanner) Scan(text []byte) Coverage {
-	if s == builtinScanner {
-		builtinScannerOnce.Do(func() {
-			if err := builtinScanner.init(BuiltinLicenses()); err != nil {
-				panic("licensecheck: initializing Scan: " + err.Error())
+	s.initBuiltin()
+
+	var warnings []string
+	if s.opts.inputWarnings {
+		warnings = inputWarnings(text)
+	}
+	if s.opts.maxTokenLen > 0 && hasLongToken(text, s.opts.maxTokenLen) {
+		return Coverage{InputWarnings: warnings}
+	}
+
+	var c Coverage
+	if s.opts.markdown {
+		md, pos := unwrapMarkdown(text)
+		c = s.scanHeader(md)
+		c.remapMarkdown(text, pos)
+	} else {
+		c = s.scanHeader(text)
+	}
+	if s.opts.collapseRepeats {
+		c.collapseRepeats()
+	}
+	s.detect(&c, text)
+	c.InputWarnings = warnings
+	return c
+}
+
+// Classify returns the license whose text makes up the largest share
+// of text, along with that share as a percentage of the normalized words
+// in text, for callers that need a single answer for a whole file,
+// such as when classifying many files at once.
+// Classify does much less work than Scan: it reports no positions or
+// other details, it does not look for license URLs or count the copyright
+// lines before a license, and it stops searching as soon as one license
+// covers more than half of text, since no other license can then cover more.
+// Its percentage can therefore be a little lower than the one Scan's
+// Coverage.Dominant reports for the same text.
+// Ties are broken in favor of the smaller ID, as in Dominant.
+// Classify honors WithMaxTokenLength, WithMarkdown, and WithMatchThreshold
+// but ignores WithHeaderScan and WithEndsOnly, classifying all of text.
+//
+// If no license matches any part of text, Classify returns "", 0.
+func (s *Scanner) Classify(text []byte) (id string, percent float64) {
+	s.initBuiltin()
+	if s.opts.maxTokenLen > 0 && hasLongToken(text, s.opts.maxTokenLen) {
+		return "", 0
+	}
+	if s.opts.markdown {
+		text, _ = unwrapMarkdown(text)
+	}
+	threshold := s.opts.threshold
+	if threshold == 0 {
+		threshold = DefaultMatchThreshold
+	}
+
+	words := make(map[string]int)
+	best := 0
+	matches := s.re.MatchFunc(string(text), threshold, func(matches *match.Matches) bool {
+		m := matches.List[len(matches.List)-1]
+		lid := s.licenses[m.ID].ID
+		words[lid] += m.End - m.Start
+		if n := words[lid]; n > best || n == best && lid < id {
+			id, best = lid, n
+		}
+		// Stop once no other license can cover more of the text.
+		return 2*best <= len(matches.Words)
+	})
+	if n := len(matches.Words); n > 0 {
+		percent = 100 * float64(best) / float64(n)
+	}
+	return id, percent
+}
+
+// ScanRange is like Scan but scans only text[start:end],
+// reporting the offsets of matches in text, not in text[start:end].
+// This allows rescanning part of an earlier scan's input, such as a gap
+// between its matches, perhaps with a Scanner using different options,
+// without translating the offsets of the new matches.
+// The Coverage's Percent is the percentage of text[start:end],
+// not of all of text, covered by matches.
+//
+// ScanRange clamps start and end to the bounds of text:
+// a negative start is treated as 0, and an end beyond len(text) as len(text).
+// If the clamped range is empty, ScanRange returns an empty Coverage.
+func (s *Scanner) ScanRange(text []byte, start, end int) Coverage {
+	if start < 0 {
+		start = 0
+	}
+	if end > len(text) {
+		end = len(text)
+	}
+	if start >= end {
+		return Coverage{}
+	}
+	c := s.Scan(text[start:end])
+	for i := range c.Match {
+		m := &c.Match[i]
+		m.Start += start
+		m.End += start
+		if m.Repeats > 0 {
+			m.LastStart += start
+			m.LastEnd += start
+		}
+	}
+	setRuneOffsets(text, c.Match)
+	c.text = text
+	return c
+}
+
+// scanHeader implements Scan, after any Markdown has been removed,
+// applying any header window set with WithHeaderScan
+// or windows set with WithEndsOnly.
+func (s *Scanner) scanHeader(text []byte) Coverage {
+	if n := s.opts.endsWords; n > 0 {
+		return s.scanEnds(text, n)
+	}
+	if n := s.opts.headerWords; n > 0 {
+		header := s.header(text, n)
+		c := s.scan(header)
+		if !s.opts.headerFallback || len(header) == len(text) {
+			return c
+		}
+		for _, m := range c.Match {
+			if !m.IsURL {
+				return c
 			}
-		})
+		}
+	}
+	return s.scan(text)
+}
+
+// header returns the prefix of text holding its first n words,
+// extended to the end of the line containing the last of them.
+func (s *Scanner) header(text []byte, n int) []byte {
+	// Split ever larger prefixes, ending at line boundaries,
+	// until one holds n words.
+	for limit := 16 * n; ; limit *= 2 {
+		size := len(text)
+		if limit < len(text) {
+			size = limit
+			if i := bytes.LastIndexByte(text[:limit], '\n'); i >= 0 {
+				size = i + 1
+			}
+		}
+		words := s.re.Dict().Split(string(text[:size]))
+		if len(words) < n {
+			if size == len(text) {
+				return text
+			}
+			continue
+		}
+		end := int(words[n-1].Hi)
+		if i := bytes.IndexByte(text[end:], '\n'); i >= 0 {
+			return text[:end+i+1]
+		}
+		return text
 	}
+}
 
-	matches := s.re.Match(string(text)) // TODO remove conversion
+// scanEnds implements scanHeader for WithEndsOnly(n).
+func (s *Scanner) scanEnds(text []byte, n int) Coverage {
+	head := s.header(text, n)
+	if len(head) == len(text) {
+		return s.scan(text)
+	}
+	start := s.trailer(text, n)
+	if start <= len(head) {
+		return s.scan(text)
+	}
+	c := s.scan(head)
+	for _, m := range c.Match {
+		if !m.IsURL {
+			return c
+		}
+	}
+	c = s.scan(text[start:])
+	for i := range c.Match {
+		c.Match[i].Start += start
+		c.Match[i].End += start
+	}
+	setRuneOffsets(text, c.Match)
+	c.text = text
+	return c
+}
+
+// trailer returns the offset of the suffix of text holding its last n words,
+// extended back to the start of the line containing the first of them.
+func (s *Scanner) trailer(text []byte, n int) int {
+	// Split ever larger suffixes, starting at line boundaries,
+	// until one holds n words.
+	for limit := 16 * n; ; limit *= 2 {
+		start := 0
+		if limit < len(text) {
+			start = len(text) - limit
+			if i := bytes.IndexByte(text[start:], '\n'); i >= 0 {
+				start += i + 1
+			}
+		}
+		words := s.re.Dict().Split(string(text[start:]))
+		if len(words) < n {
+			if start == 0 {
+				return 0
+			}
+			continue
+		}
+		lo := start + int(words[len(words)-n].Lo)
+		return bytes.LastIndexByte(text[:lo], '\n') + 1
+	}
+}
+
+// scan implements Scan, after any header window has been applied.
+func (s *Scanner) scan(text []byte) Coverage {
+	threshold := s.opts.threshold
+	if threshold == 0 {
+		threshold = DefaultMatchThreshold
+	}
+	if s.opts.normTrace != nil {
+		s.traceNormalization(text)
+	}
+	dropped := false
+	matches := s.re.MatchFunc(string(text), threshold, func(m *match.Matches) bool { // TODO remove conversion
+		if n := s.opts.maxMatches; n > 0 && len(m.List) > n {
+			dropSmallest(m)
+			dropped = true
+		}
+		return true
+	})
 
 	var c Coverage
 	words := matches.Words
@@ -140,18 +876,10 @@ func (s *Scanner) Scan(text []byte) Coverage {
 	// Add sentinel match trigger URL scan from last match to end of text.
 	matches.List = append(matches.List, match.Match{Start: len(words), ID: -1})
 
-	for _, m := range matches.List {
+	for k, m := range matches.List {
+		lreStart := m.Start // before any copyright lines are added
 		if m.Start < len(words) && lastEnd < m.Start && copyright >= 0 {
-			limit := m.Start - maxCopyrightWords
-			if limit < lastEnd {
-				limit = lastEnd
-			}
-			for i := limit; i < m.Start; i++ {
-				if words[i].ID == copyright {
-					m.Start = i
-					break
-				}
-			}
+			m.Start = copyrightStart(words, lastEnd, m.Start, copyright)
 		}
 
 		// Pick up any URLs before m.Start.
@@ -164,17 +892,21 @@ func (s *Scanner) Scan(text []byte) Coverage {
 				if u := urlScanRE.FindIndex(text[w.Lo:]); u != nil && (m.Start == len(words) || int(w.Lo)+u[1] <= int(words[m.Start].Lo)) {
 					u0, u1 := int(w.Lo)+u[0], int(w.Lo)+u[1]
 					if l, ok := s.licenseURL(string(text[u0:u1])); ok {
+						start := i
+						for i < m.Start && int(words[i].Hi) <= u1 {
+							i++
+						}
 						c.Match = append(c.Match, Match{
 							ID:    l.ID,
 							Type:  l.Type,
 							Start: u0,
 							End:   u1,
+							Words: i - start,
 							IsURL: true,
+
+							wordStart: start,
+							wordEnd:   i,
 						})
-						start := i
-						for i < m.Start && int(words[i].Hi) <= u1 {
-							i++
-						}
 						total += i - start
 						i-- // counter loop i++
 					}
@@ -186,6 +918,15 @@ func (s *Scanner) Scan(text []byte) Coverage {
 			break
 		}
 
+		l := &s.licenses[m.ID]
+		id := l.ID
+		if versions, ok := familyVersions[id]; ok {
+			if v, end := versionAfter(text, words, m.End, matches.List[k+1].Start); versions[v] != "" {
+				id = versions[v]
+				m.End = end
+			}
+		}
+
 		start := int(words[m.Start].Lo) // byte offset (unlike m.Start)
 		if m.Start == 0 {
 			start = 0
@@ -204,31 +945,266 @@ func (s *Scanner) Scan(text []byte) Coverage {
 				end = end + i + 1
 			}
 		}
-		l := &s.licenses[m.ID]
+		lreByte := int(words[lreStart].Lo)                         // byte offset of the end of any copyright lines
+		truncated := matches.Truncated && k == len(matches.List)-2 // last before sentinel
+		sections := s.re.LRE(m.ID).Sections()
+		if (truncated || m.Partial) && sections != nil {
+			sections = s.re.LRE(m.ID).MatchSections(matches.Text, words[lreStart:])
+		}
 		c.Match = append(c.Match, Match{
-			ID:    l.ID,
-			Type:  l.Type,
-			Start: start,
-			End:   end,
+			ID:             id,
+			Type:           l.Type,
+			Start:          start,
+			End:            end,
+			Words:          m.End - m.Start,
+			Complete:       !l.IsNotice && !l.IsReference && !l.IsGrant && !truncated && !m.Partial,
+			IsNotice:       l.IsNotice,
+			IsFragment:     l.IsFragment,
+			IsReference:    l.IsReference,
+			IsGrant:        l.IsGrant,
+			TruncatedAtEnd: truncated,
+			Lang:           l.Lang,
+			CopyrightYears: copyrightYears(text, words, m.Start, m.End, copyright),
+			Sections:       sections,
+
+			UnfilledPlaceholders: s.unfilledPlaceholders(text, m.ID, start, lreByte, end),
+
+			wordStart: m.Start,
+			wordEnd:   m.End,
 		})
 		total += m.End - m.Start
 		lastEnd = m.End
 	}
 
+	setRiders(c.Match)
+	setNoticeFile(text, words, c.Match)
+	if n := s.opts.maxMatches; n > 0 && len(c.Match) > n {
+		total = c.truncate(n)
+	}
+	if dropped {
+		c.Truncated = true
+	}
+
 	if len(words) > 0 { // len(words)==0 should be impossible, but avoid NaN
 		c.Percent = 100.0 * float64(total) / float64(len(words))
 	}
+	if s.weights != nil {
+		c.Percent = s.weightedPercent(words, c.Match)
+	}
+	setRuneOffsets(text, c.Match)
+	c.text = text
 
 	return c
 }
 
+// familyVersions maps the ID of each reference to a license family
+// without a version, such as "Licensed under the GNU LGPL", to the IDs
+// of the family's versions, by the version number written after it.
+var familyVersions = map[string]map[string]string{
+	"AGPL": {"1": "AGPL-1.0", "1.0": "AGPL-1.0", "3": "AGPL-3.0", "3.0": "AGPL-3.0"},
+	"GPL":  {"1": "GPL-1.0", "1.0": "GPL-1.0", "2": "GPL-2.0", "2.0": "GPL-2.0", "3": "GPL-3.0", "3.0": "GPL-3.0"},
+	"LGPL": {"2": "LGPL-2.0", "2.0": "LGPL-2.0", "2.1": "LGPL-2.1", "3": "LGPL-3.0", "3.0": "LGPL-3.0"},
+}
+
+// versionAfter returns the version number written at words[i:limit],
+// like "2.1" for "version 2.1", "v2.1", or "2.1", and the index of
+// the word following it. If there is no version number there,
+// versionAfter returns "", i.
+func versionAfter(text []byte, words []match.Word, i, limit int) (version string, end int) {
+	word := func(j int) string {
+		return strings.ToLower(string(text[words[j].Lo:words[j].Hi]))
+	}
+	j := i
+	if j < limit {
+		switch word(j) {
+		case "version", "ver", "v":
+			j++
+		}
+	}
+	if j >= limit {
+		return "", i
+	}
+	version = strings.TrimPrefix(word(j), "v")
+	if !isDigits(version) {
+		return "", i
+	}
+	j++
+	if j < limit && string(text[words[j-1].Hi:words[j].Lo]) == "." && isDigits(word(j)) {
+		version += "." + word(j)
+		j++
+	}
+	return version, j
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// riders is the set of IDs of licenses that are riders:
+// conditions added to another license, not licenses in their own right.
+var riders = map[string]bool{
+	"CommonsClause": true,
+}
+
+// maxRiderGap is the maximum number of words between a rider
+// and the license it modifies, such as the "Software:", "License:",
+// and "Licensor:" lines that the Commons Clause asks for.
+const maxRiderGap = 30
+
+// setRiders sets the Rider field of each match in list that a rider,
+// also in list, modifies. A rider modifies the license it precedes,
+// since the Commons Clause refers to "the License, as defined below",
+// or else the license it follows, if either is within maxRiderGap words.
+func setRiders(list []Match) {
+	for i := range list {
+		if !riders[list[i].ID] {
+			continue
+		}
+		j := riderBase(list, i, +1)
+		if j < 0 {
+			j = riderBase(list, i, -1)
+		}
+		if j >= 0 {
+			list[j].Rider = list[i].ID
+		}
+	}
+}
+
+// riderBase returns the index of the license match that the rider list[i]
+// modifies, looking forward if dir is +1 or backward if dir is -1.
+// It skips URL matches. It returns -1 if there is no such match.
+func riderBase(list []Match, i, dir int) int {
+	r := list[i]
+	for j := i + dir; 0 <= j && j < len(list); j += dir {
+		m := list[j]
+		if m.IsURL {
+			continue
+		}
+		gap := m.wordStart - r.wordEnd
+		if dir < 0 {
+			gap = r.wordStart - m.wordEnd
+		}
+		if riders[m.ID] || gap > maxRiderGap {
+			return -1
+		}
+		return j
+	}
+	return -1
+}
+
+// collapseRepeats replaces each run of consecutive matches in c
+// of the same license with the same normalized text by the first of them,
+// as described in WithCollapseRepeats.
+func (c *Coverage) collapseRepeats() {
+	var list []Match
+	last := "" // NormalizedHash of list[len(list)-1]
+	for _, m := range c.Match {
+		h := c.NormalizedHash(m)
+		if n := len(list); n > 0 && h != "" && h == last && list[n-1].ID == m.ID {
+			p := &list[n-1]
+			if p.Repeats == 0 {
+				p.Repeats = 1
+			}
+			p.Repeats++
+			p.Words += m.Words
+			p.LastStart, p.LastEnd = m.Start, m.End
+			continue
+		}
+		list = append(list, m)
+		last = h
+	}
+	c.Match = list
+}
+
+// setRuneOffsets sets the RuneStart and RuneEnd fields of the matches in list,
+// which must be in text order and must not overlap.
+func setRuneOffsets(text []byte, list []Match) {
+	off, n := 0, 0 // n is the number of runes in text[:off]
+	for i := range list {
+		m := &list[i]
+		n += utf8.RuneCount(text[off:m.Start])
+		m.RuneStart = n
+		n += utf8.RuneCount(text[m.Start:m.End])
+		m.RuneEnd = n
+		off = m.End
+	}
+}
+
+// hasLongToken reports whether text contains a run of
+// more than n bytes without ASCII spacing.
+func hasLongToken(text []byte, n int) bool {
+	run := 0
+	for _, c := range text {
+		switch c {
+		case ' ', '\t', '\n', '\r', '\v', '\f':
+			run = 0
+		default:
+			if run++; run > n {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// truncate reduces c.Match to the n matches covering the most words,
+// preserving their order in the text, and sets c.Truncated.
+// Ties are broken in favor of earlier matches.
+// truncate returns the total number of words covered by the kept matches.
+func (c *Coverage) truncate(n int) int {
+	index := make([]int, len(c.Match))
+	for i := range index {
+		index[i] = i
+	}
+	sort.SliceStable(index, func(i, j int) bool {
+		return c.Match[index[i]].Words > c.Match[index[j]].Words
+	})
+	index = index[:n]
+	sort.Ints(index)
+
+	total := 0
+	keep := make([]Match, 0, n)
+	for _, i := range index {
+		keep = append(keep, c.Match[i])
+		total += c.Match[i].Words
+	}
+	c.Match = keep
+	c.Truncated = true
+	return total
+}
+
+// dropSmallest removes from m the match covering the fewest words,
+// the last of them if several tie, as truncate would.
+// Scan calls it as each match is found, to keep no more matches
+// in memory than WithMaxMatches allows.
+func dropSmallest(m *match.Matches) {
+	min := 0
+	for i, x := range m.List {
+		if x.End-x.Start <= m.List[min].End-m.List[min].Start {
+			min = i
+		}
+	}
+	if min == len(m.List)-1 {
+		m.Truncated = false // only the last match can be truncated
+	}
+	m.List = append(m.List[:min], m.List[min+1:]...)
+}
+
 // licenseURL reports whether url is a known URL, and returns its name if it is.
 func (s *Scanner) licenseURL(url string) (License, bool) {
 	// We need to canonicalize the text for lookup.
-	// First, trim the leading http:// or https:// and the trailing /.
+	// First, trim the leading http:// or https:// and the trailing /
+	// or sentence-ending period.
 	// Then we lower-case it.
 	url = strings.TrimPrefix(url, "http://")
 	url = strings.TrimPrefix(url, "https://")
+	url = strings.TrimSuffix(url, ".")
 	url = strings.TrimSuffix(url, "/")
 	url = strings.TrimSuffix(url, "/legalcode") // Common for CC licenses.
 	url = strings.ToLower(url)
@@ -0,0 +1,1118 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// newTestScanner returns a Scanner for the builtin licenses with the given IDs,
+// which is much faster to construct than a Scanner for all the builtin licenses.
+func newTestScanner(t *testing.T, ids []string, opts ...Option) *Scanner {
+	t.Helper()
+	want := make(map[string]bool)
+	for _, id := range ids {
+		want[id] = true
+	}
+	var list []License
+	for _, l := range BuiltinLicenses() {
+		if want[l.ID] {
+			list = append(list, l)
+		}
+	}
+	s, err := NewScanner(list, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestMaxMatches(t *testing.T) {
+	text := []byte("See http://opensource.org/licenses/upl for details.\n" + license_MIT + license_MIT)
+
+	s := newTestScanner(t, []string{"MIT", "UPL-1.0"})
+	full := s.Scan(text)
+	if len(full.Match) != 3 || full.Truncated {
+		t.Fatalf("Scan without limit: %d matches, Truncated=%v, want 3, false", len(full.Match), full.Truncated)
+	}
+
+	s = newTestScanner(t, []string{"MIT", "UPL-1.0"}, WithMaxMatches(2))
+	cov := s.Scan(text)
+	if !cov.Truncated {
+		t.Errorf("Scan with limit: Truncated=false, want true")
+	}
+	if len(cov.Match) != 2 || !reflect.DeepEqual(cov.Match, full.Match[1:]) {
+		t.Errorf("Scan with limit: Match=%v, want %v", cov.Match, full.Match[1:])
+	}
+	if cov.Percent >= full.Percent {
+		t.Errorf("Scan with limit: Percent=%.1f, want < %.1f", cov.Percent, full.Percent)
+	}
+
+	s = newTestScanner(t, []string{"MIT", "UPL-1.0"}, WithMaxMatches(3))
+	cov = s.Scan(text)
+	if cov.Truncated || len(cov.Match) != 3 || cov.Percent != full.Percent {
+		t.Errorf("Scan with limit 3: %d matches, Truncated=%v, Percent=%.1f, want 3, false, %.1f", len(cov.Match), cov.Truncated, cov.Percent, full.Percent)
+	}
+
+	// Matches dropped while scanning, before the URL, still set Truncated.
+	s = newTestScanner(t, []string{"MIT", "UPL-1.0"}, WithMaxMatches(1))
+	cov = s.Scan(text)
+	if !cov.Truncated || !reflect.DeepEqual(cov.Match, full.Match[1:2]) {
+		t.Errorf("Scan with limit 1: Match=%v, Truncated=%v, want %v, true", cov.Match, cov.Truncated, full.Match[1:2])
+	}
+}
+
+func FuzzScan(f *testing.F) {
+	files, err := filepath.Glob("testdata/*.t1")
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+		f.Add(data[:len(data)/2])
+		// Truncate in the middle of a multibyte UTF-8 sequence, if any.
+		for i := len(data) - 1; i > 0; i-- {
+			if data[i] >= utf8.RuneSelf && !utf8.RuneStart(data[i]) {
+				f.Add(data[:i])
+				break
+			}
+		}
+	}
+	f.Add([]byte(license_MIT))
+	f.Add([]byte("Copyright \xe2\x80 http://opensource.org/licenses/upl \xff"))
+
+	f.Fuzz(func(t *testing.T, text []byte) {
+		cov := Scan(text)
+		if cov.Percent < 0 || cov.Percent > 100 || math.IsNaN(cov.Percent) {
+			t.Errorf("Percent = %v, want value in [0, 100]", cov.Percent)
+		}
+		end := 0
+		for _, m := range cov.Match {
+			if m.Start < end || m.End < m.Start || m.End > len(text) {
+				t.Errorf("match %s at [%d:%d] out of order or out of bounds (len %d, previous end %d)", m.ID, m.Start, m.End, len(text), end)
+			}
+			end = m.End
+		}
+	})
+}
+
+func TestMaxTokenLength(t *testing.T) {
+	blob := bytes.Repeat([]byte("QUJDREVGR0g="), 5<<20/12)
+	text := append([]byte(license_MIT), blob...)
+
+	s := newTestScanner(t, []string{"MIT"})
+	if cov := s.Scan(text); len(cov.Match) != 1 {
+		t.Errorf("Scan without limit: %d matches, want 1", len(cov.Match))
+	}
+
+	s = newTestScanner(t, []string{"MIT"}, WithMaxTokenLength(1000))
+	if cov := s.Scan(text); len(cov.Match) != 0 || cov.Percent != 0 {
+		t.Errorf("Scan with limit: %v, want empty Coverage", cov)
+	}
+	if cov := s.Scan([]byte(license_MIT)); len(cov.Match) != 1 {
+		t.Errorf("Scan with limit: %d matches for short tokens, want 1", len(cov.Match))
+	}
+}
+
+func TestOnError(t *testing.T) {
+	licenses := []License{
+		{ID: "Bad", LRE: "a (( b"},
+		{ID: "MyMIT", LRE: license_MIT},
+		{ID: "Worse", LRE: "a || b"},
+	}
+	if _, err := NewScanner(licenses); err == nil {
+		t.Fatalf("NewScanner with bad LRE succeeded, want error")
+	}
+
+	var skipped []string
+	s, err := NewScanner(licenses, WithOnError(func(id string, err error) {
+		if err == nil {
+			t.Errorf("OnError(%q, nil), want non-nil error", id)
+		}
+		skipped = append(skipped, id)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 2 || skipped[0] != "Bad" || skipped[1] != "Worse" {
+		t.Errorf("skipped %v, want [Bad Worse]", skipped)
+	}
+	cov := s.Scan([]byte(license_MIT))
+	if len(cov.Match) != 1 || cov.Match[0].ID != "MyMIT" {
+		t.Errorf("Scan: Match=%v, want one MyMIT match", cov.Match)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, tt := range []struct {
+		lre  string
+		want ParseError
+	}{
+		{"a b c\n((\n\td e", ParseError{"X", "", 3, 5, "missing )) at end", ""}},
+		{"a b c\nd e\n))", ParseError{"X", "", 3, 1, "unexpected ))", ""}},
+		{"a b c\n((\n\td e __3__\n))??", ParseError{"X", "", 0, 0, "__3__ wildcard with no required text following", ""}},
+		{"__3__ a b c", ParseError{"X", "", 0, 0, "invalid pattern: begins with wildcard phrase: __ a", ""}},
+		{"a b\n//** unterminated\nc d", ParseError{"X", "", 2, 1, "opening //** without closing **//", ""}},
+		{"a b\n<<include Missing>>\n", ParseError{"X", "", 2, 1, "undefined include Missing", ""}},
+		{"a b\n<<include Bad>>\n", ParseError{"X", "Bad", 2, 1, "undefined include Nope", ""}},
+
+		// An error found after expanding includes is in the expanded text.
+		{"a b\n<<include Worse>>\n", ParseError{"X", "", 3, 3, "|| outside (( ))", ""}},
+	} {
+		licenses := []License{{ID: "X", LRE: tt.lre}}
+		if strings.Contains(tt.lre, "<<include") {
+			licenses = append(licenses,
+				License{ID: "Bad", LRE: "b c\n<<include Nope>>\n"},
+				License{ID: "Worse", LRE: "b c\nd || e"})
+		}
+		_, err := NewScanner(licenses)
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("NewScanner(%q): error %v, want *ParseError", tt.lre, err)
+			continue
+		}
+		if *pe != tt.want {
+			t.Errorf("NewScanner(%q): error %+v, want %+v", tt.lre, *pe, tt.want)
+		}
+	}
+
+	// WithOnError gets the same errors.
+	var have []*ParseError
+	_, err := NewScanner([]License{{ID: "A", LRE: "a (( b"}, {ID: "MyMIT", LRE: license_MIT}}, WithOnError(func(id string, err error) {
+		if pe, ok := err.(*ParseError); ok {
+			have = append(have, pe)
+		} else {
+			t.Errorf("OnError(%q, %v): error %T, want *ParseError", id, err, err)
+		}
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*ParseError{{"A", "", 1, 3, "(( not at beginning of line", ""}}; !reflect.DeepEqual(have, want) {
+		t.Errorf("OnError errors = %+v, want %+v", have, want)
+	}
+	if want := "parsing A:1:3: (( not at beginning of line"; have[0].Error() != want {
+		t.Errorf("Error() = %q, want %q", have[0].Error(), want)
+	}
+}
+
+func TestInclude(t *testing.T) {
+	const header = "This file is part of the Example project.\nAll rights reserved by Example Corp."
+	licenses := []License{
+		{ID: "Example-Header", LRE: header},
+		{ID: "Example-MIT", LRE: "<<include Example-Header>>\n" + license_MIT},
+	}
+	s, err := NewScanner(licenses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cov := s.Scan([]byte(header + "\n\n" + license_MIT))
+	if len(cov.Match) != 1 || cov.Match[0].ID != "Example-MIT" {
+		t.Errorf("Scan(header+MIT): Match=%v, want one Example-MIT match", cov.Match)
+	}
+	if pct, ok := s.Coverage([]byte(header+"\n"+license_MIT), "Example-MIT"); !ok || pct < 99 {
+		t.Errorf("Coverage(header+MIT, Example-MIT) = %.1f, %v, want 100, true", pct, ok)
+	}
+
+	for _, bad := range [][]License{
+		{{ID: "A", LRE: "<<include B>>\nmore words"}},
+		{{ID: "A", LRE: "<<include B>>\nmore words"}, {ID: "B", LRE: "<<include A>>\nother words"}},
+	} {
+		if _, err := NewScanner(bad); err == nil {
+			t.Errorf("NewScanner(%v) succeeded, want error", bad)
+		} else if !strings.Contains(err.Error(), "parsing A:") {
+			t.Errorf("NewScanner(%v): error %q, want error parsing A", bad, err)
+		}
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT", "ISC"})
+	var b bytes.Buffer
+	if err := s.WriteDOT(&b); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	if !strings.HasPrefix(out, "digraph dfa {\n") || !strings.HasSuffix(out, "}\n") {
+		t.Errorf("WriteDOT output is not a digraph:\n%.200s", out)
+	}
+	for _, id := range []string{"MIT", "ISC"} {
+		if !strings.Contains(out, `\n`+id+`"]`) {
+			t.Errorf("WriteDOT output has no accepting state for %s", id)
+		}
+	}
+}
+
+func TestRuneOffsets(t *testing.T) {
+	// "é" is two bytes, "\xff" is one invalid byte counted as one rune,
+	// and "\xe2\x80" is a truncated sequence counted as two runes.
+	list := []Match{{Start: 1, End: 4}, {Start: 7, End: 11}}
+	setRuneOffsets([]byte("aé\xffb\xe2\x80cdé"), list)
+	want := []Match{{Start: 1, End: 4, RuneStart: 1, RuneEnd: 3}, {Start: 7, End: 11, RuneStart: 6, RuneEnd: 9}}
+	for i := range list {
+		if !reflect.DeepEqual(list[i], want[i]) {
+			t.Errorf("setRuneOffsets: Match[%d] = %+v, want %+v", i, list[i], want[i])
+		}
+	}
+
+	text := []byte("Möbius \xff Straße\n\n" + license_MIT)
+	s := newTestScanner(t, []string{"MIT"})
+	cov := s.Scan(text)
+	if len(cov.Match) != 1 {
+		t.Fatalf("Scan: %d matches, want 1", len(cov.Match))
+	}
+	m := cov.Match[0]
+	if m.RuneStart != utf8.RuneCount(text[:m.Start]) || m.RuneEnd != utf8.RuneCount(text[:m.End]) {
+		t.Errorf("Scan: match at bytes [%d:%d], runes [%d:%d], want runes [%d:%d]", m.Start, m.End, m.RuneStart, m.RuneEnd, utf8.RuneCount(text[:m.Start]), utf8.RuneCount(text[:m.End]))
+	}
+	if m.RuneStart == m.Start {
+		t.Errorf("Scan: RuneStart = Start = %d, want fewer runes than bytes", m.Start)
+	}
+}
+
+func TestComplete(t *testing.T) {
+	signed := license_MIT + `
+-----BEGIN PGP SIGNATURE-----
+
+iQEzBAEBCAAdFiEEy0Kb6G1V3yyzC9chrvAkt8dTAMkFAl6QKJUACgkQrvAkt8dT
+AMnZ5Af/cOBn9E0VyqcUnTLNoc0cIaf9zB5Wnm2EQKHpqXmNlUSe0zF8Adh7f4E3
+=Xr5s
+-----END PGP SIGNATURE-----
+Generated by the release tool.
+`
+	s := newTestScanner(t, []string{"MIT", "UPL-1.0"})
+	cov := s.Scan([]byte(signed))
+	if len(cov.Match) != 1 || !cov.Match[0].Complete {
+		t.Errorf("Scan(MIT + signature): Match=%+v, want one complete match", cov.Match)
+	}
+	if cov.Percent >= 100 {
+		t.Errorf("Scan(MIT + signature): Percent=%.1f, want < 100", cov.Percent)
+	}
+
+	cov = s.Scan([]byte("See http://opensource.org/licenses/upl for details.\n"))
+	if len(cov.Match) != 1 || cov.Match[0].Complete {
+		t.Errorf("Scan(URL): Match=%+v, want one incomplete match", cov.Match)
+	}
+}
+
+func TestCanonicalText(t *testing.T) {
+	s, err := NewScanner([]License{
+		{ID: "MIT", LRE: license_MIT, Text: license_MIT},
+		{ID: "UPL-1.0", URL: "opensource.org/licenses/upl"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text, ok := s.CanonicalText("MIT"); !ok || text != license_MIT {
+		t.Errorf("CanonicalText(MIT) = %.20q, %v, want %.20q, true", text, ok, license_MIT)
+	}
+	if text, ok := s.CanonicalText("UPL-1.0"); ok || text != "" {
+		t.Errorf("CanonicalText(UPL-1.0) = %.20q, %v, want \"\", false", text, ok)
+	}
+
+	// The built-in licenses have only the texts that the caller supplies.
+	var list []License
+	for _, l := range BuiltinLicenses() {
+		if l.ID == "MIT" {
+			l.Text = license_MIT
+		}
+		list = append(list, l)
+	}
+	s, err = NewScanner(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text, ok := s.CanonicalText("MIT"); !ok || text != license_MIT {
+		t.Errorf("CanonicalText(MIT) with built-in licenses = %.20q, %v, want %.20q, true", text, ok, license_MIT)
+	}
+	if text, ok := s.CanonicalText("Apache-2.0"); ok || text != "" {
+		t.Errorf("CanonicalText(Apache-2.0) with built-in licenses = %.20q, %v, want \"\", false", text, ok)
+	}
+}
+
+func TestSetCanonicalText(t *testing.T) {
+	s, err := NewScanner([]License{
+		{ID: "MIT", LRE: license_MIT, Text: license_MIT},
+		{ID: "UPL-1.0", URL: "opensource.org/licenses/upl"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const approved = "Our approved MIT wording."
+	if err := s.SetCanonicalText("MIT", approved); err != nil {
+		t.Fatalf("SetCanonicalText(MIT): %v", err)
+	}
+	if text, ok := s.CanonicalText("MIT"); !ok || text != approved {
+		t.Errorf("CanonicalText(MIT) = %.20q, %v, want %.20q, true", text, ok, approved)
+	}
+	if err := s.SetCanonicalText("UPL-1.0", approved); err != nil {
+		t.Fatalf("SetCanonicalText(UPL-1.0): %v", err)
+	}
+	if text, ok := s.CanonicalText("UPL-1.0"); !ok || text != approved {
+		t.Errorf("CanonicalText(UPL-1.0) = %.20q, %v, want %.20q, true", text, ok, approved)
+	}
+	if err := s.SetCanonicalText("Unknown-1.0", approved); err == nil {
+		t.Errorf("SetCanonicalText(Unknown-1.0) succeeded, want error")
+	}
+	if _, ok := s.CanonicalText("Unknown-1.0"); ok {
+		t.Errorf("CanonicalText(Unknown-1.0) = true after failed SetCanonicalText, want false")
+	}
+
+	// Matching is unaffected.
+	if cov := s.Scan([]byte(license_MIT)); len(cov.Match) != 1 || cov.Match[0].ID != "MIT" {
+		t.Errorf("Scan(MIT) after SetCanonicalText = %+v, want MIT match", cov.Match)
+	}
+}
+
+func TestScannerCoverage(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT", "ISC"})
+	text := []byte(license_MIT + "\nSome trailing words that are not part of any license.\n")
+	want := s.Scan(text).Percent
+	if want <= 0 || want >= 100 {
+		t.Fatalf("Scan: Percent=%.1f, want partial coverage", want)
+	}
+	if percent, ok := s.Coverage(text, "MIT"); !ok || percent != want {
+		t.Errorf("Coverage(MIT) = %.1f, %v, want %.1f, true", percent, ok, want)
+	}
+	if percent, ok := s.Coverage(text, "ISC"); !ok || percent != 0 {
+		t.Errorf("Coverage(ISC) = %.1f, %v, want 0, true", percent, ok)
+	}
+	if percent, ok := s.Coverage(text, "Apache-2.0"); ok || percent != 0 {
+		t.Errorf("Coverage(Apache-2.0) = %.1f, %v, want 0, false", percent, ok)
+	}
+}
+
+func TestLooseNumbers(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/CERN-OHL-1.1.t2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := data[bytes.Index(data, []byte("\n\n"))+2:]
+
+	s := newTestScanner(t, []string{"CERN-OHL-1.1"})
+	if cov := s.Scan(text); len(cov.Match) != 0 {
+		t.Errorf("Scan(renumbered) = %v, want no matches", cov.Match)
+	}
+
+	s = newTestScanner(t, []string{"CERN-OHL-1.1"}, WithLooseNumbers(true))
+	cov := s.Scan(text)
+	if len(cov.Match) != 1 || cov.Match[0].ID != "CERN-OHL-1.1" || cov.Percent != 100 {
+		t.Errorf("Scan(renumbered) with loose numbers = %v, want 100%% CERN-OHL-1.1", cov)
+	}
+	if cov := s.Scan([]byte(license_MIT)); len(cov.Match) != 0 {
+		t.Errorf("Scan(MIT) with loose numbers = %v, want no matches", cov.Match)
+	}
+}
+
+func TestMPLVersions(t *testing.T) {
+	// MPL-1.1 and MPL-2.0 share much of their vocabulary but must not
+	// match each other's text, in either order.
+	text := func(file string) []byte {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data[bytes.Index(data, []byte("\n\n"))+2:]
+	}
+	v1, v2 := text("testdata/MPL-1.1.t1"), text("testdata/MPL-2.0.t1")
+
+	for _, tt := range []struct {
+		text []byte
+		ids  []string
+	}{
+		{append(append([]byte{}, v1...), v2...), []string{"MPL-1.1", "MPL-2.0"}},
+		{append(append([]byte{}, v2...), v1...), []string{"MPL-2.0", "MPL-1.1"}},
+	} {
+		cov := Scan(tt.text)
+		var ids []string
+		for _, m := range cov.Match {
+			if !m.Complete {
+				t.Errorf("Scan(%v): %s match not Complete", tt.ids, m.ID)
+			}
+			ids = append(ids, m.ID)
+		}
+		if !reflect.DeepEqual(ids, tt.ids) || cov.Percent != 100 {
+			t.Errorf("Scan(%v) = %.1f%% %v, want 100%% %v", tt.ids, cov.Percent, ids, tt.ids)
+		}
+	}
+}
+
+func TestLicenseName(t *testing.T) {
+	for _, tt := range []struct{ id, name string }{
+		{"Apache-2.0", "Apache License 2.0"},
+		{"MIT", "MIT License"},
+		{"GPL-2.0-only", "GNU General Public License v2.0 only"},
+		{"NoSuchLicense", ""},
+	} {
+		if name := builtinScanner.LicenseName(tt.id); name != tt.name {
+			t.Errorf("LicenseName(%q) = %q, want %q", tt.id, name, tt.name)
+		}
+	}
+
+	s, err := NewScanner([]License{
+		{ID: "MIT", LRE: license_MIT},
+		{ID: "MIT", Name: "The MIT License", LRE: license_MIT},
+		{ID: "MIT", Name: "Expat", URL: "opensource.org/licenses/MIT"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := s.LicenseName("MIT"); name != "The MIT License" {
+		t.Errorf("LicenseName(MIT) = %q, want %q", name, "The MIT License")
+	}
+}
+
+func TestHeaderScan(t *testing.T) {
+	filler := strings.Repeat("The quick brown fox jumps over the lazy dog.\n", 200)
+	head := []byte(license_MIT + "\n" + filler)
+	tail := []byte(filler + "\n" + license_MIT)
+
+	s := newTestScanner(t, []string{"MIT"}, WithHeaderScan(300))
+	h := s.header(head, 300)
+	if n := len(s.re.Dict().Split(string(h))); n < 300 || n > 310 || h[len(h)-1] != '\n' {
+		t.Errorf("header(300) has %d words, ends in %q; want about 300 words, ending in newline", n, h[len(h)-1])
+	}
+	cov := s.Scan(head)
+	full := newTestScanner(t, []string{"MIT"}).Scan(head)
+	if len(cov.Match) != 1 || !reflect.DeepEqual(cov.Match[0], full.Match[0]) {
+		t.Errorf("Scan(license first) = %v, want %v", cov.Match, full.Match)
+	}
+	if cov.Percent <= full.Percent {
+		t.Errorf("Scan(license first): Percent = %.1f, want > %.1f (percentage of header only)", cov.Percent, full.Percent)
+	}
+	if cov := s.Scan(tail); len(cov.Match) != 0 {
+		t.Errorf("Scan(license last) = %v, want no matches", cov.Match)
+	}
+
+	s = newTestScanner(t, []string{"MIT"}, WithHeaderScan(300), WithHeaderFallback(true))
+	full = newTestScanner(t, []string{"MIT"}).Scan(tail)
+	if cov := s.Scan(tail); !reflect.DeepEqual(cov, full) {
+		t.Errorf("Scan(license last) with fallback = %v, want %v", cov, full)
+	}
+}
+
+func TestEndsOnly(t *testing.T) {
+	filler := strings.Repeat("The quick brown fox jumps over the lazy dog.\n", 200)
+	head := []byte(license_MIT + "\n" + filler)
+	tail := []byte(filler + "\n" + license_MIT)
+	middle := []byte(filler + "\n" + license_MIT + "\n" + filler)
+
+	s := newTestScanner(t, []string{"MIT"}, WithEndsOnly(300))
+	full := newTestScanner(t, []string{"MIT"})
+	for _, tt := range []struct {
+		name string
+		text []byte
+	}{
+		{"license first", head},
+		{"license last", tail},
+	} {
+		cov := s.Scan(tt.text)
+		want := full.Scan(tt.text).Match[0]
+		if len(cov.Match) != 1 || cov.Match[0].Start != want.Start || cov.Match[0].End != want.End ||
+			cov.Match[0].RuneStart != want.RuneStart || cov.Match[0].ID != want.ID {
+			t.Errorf("Scan(%s) = %v, want [%v]", tt.name, cov.Match, want)
+		}
+	}
+	if start := s.trailer(tail, 300); start == 0 || tail[start-1] != '\n' {
+		t.Errorf("trailer(300) = %d, want start of a line after the start of text", start)
+	}
+	if cov := s.Scan(middle); len(cov.Match) != 0 {
+		t.Errorf("Scan(license in middle) = %v, want no matches", cov.Match)
+	}
+
+	// Short texts are scanned in full.
+	short := []byte("Some words.\n" + license_MIT + "\nMore words.\n")
+	if have, want := s.Scan(short), full.Scan(short); !reflect.DeepEqual(have, want) {
+		t.Errorf("Scan(short) = %v, want %v", have, want)
+	}
+}
+
+func TestIsOSIApproved(t *testing.T) {
+	for _, tt := range []struct {
+		id   string
+		want bool
+	}{
+		{"Apache-2.0", true},
+		{"MIT", true},
+		{"GPL-2.0-or-later", true},
+		{"MPL-2.0-no-copyleft-exception", true},
+		{"CC-BY-4.0", false},
+		{"JSON", false},
+		{"NoSuchLicense", false},
+	} {
+		if have := builtinScanner.IsOSIApproved(tt.id); have != tt.want {
+			t.Errorf("IsOSIApproved(%q) = %v, want %v", tt.id, have, tt.want)
+		}
+	}
+
+	s, err := NewScanner([]License{
+		{ID: "MyMIT", LRE: license_MIT},
+		{ID: "MyMIT", URL: "example.com/mymit", OSIApproved: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.IsOSIApproved("MyMIT") {
+		t.Errorf("IsOSIApproved(MyMIT) = false, want true")
+	}
+}
+
+func TestTruncatedAtEnd(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT"})
+	cov := s.Scan([]byte(license_MIT))
+	if len(cov.Match) != 1 || cov.Match[0].TruncatedAtEnd || !cov.Match[0].Complete {
+		t.Fatalf("Scan(MIT) = %+v, want one complete match", cov.Match)
+	}
+
+	half := license_MIT[:strings.LastIndex(license_MIT[:len(license_MIT)/2], "\n")+1]
+	text := []byte(half)
+	cov = s.Scan(text)
+	if len(cov.Match) != 1 {
+		t.Fatalf("Scan(half of MIT) = %+v, want one match", cov.Match)
+	}
+	m := cov.Match[0]
+	if m.ID != "MIT" || !m.TruncatedAtEnd || m.Complete || m.End != len(text) {
+		t.Errorf("Scan(half of MIT) = %+v, want MIT, TruncatedAtEnd, not Complete, ending at %d", m, len(text))
+	}
+
+	if cov := s.Scan([]byte(half + "The end.\n")); len(cov.Match) != 0 {
+		t.Errorf("Scan(half of MIT, then other text) = %+v, want no matches", cov.Match)
+	}
+
+	// The end of the input can cut a word short.
+	cut := []byte(half + license_MIT[len(half):len(half)+3])
+	if cov := s.Scan(cut); len(cov.Match) != 1 || !cov.Match[0].TruncatedAtEnd || cov.Match[0].End != len(cut) {
+		t.Errorf("Scan(half of MIT, cut mid-word) = %+v, want MIT, TruncatedAtEnd, ending at %d", cov.Match, len(cut))
+	}
+}
+
+func TestTruncatedAtEndBuiltin(t *testing.T) {
+	for _, tt := range []struct {
+		file, id string
+		frac     int
+		want     bool
+	}{
+		// Until the clauses that tell them apart, the text of
+		// the BSD licenses and Apache-1.0 is shared, so a prefix
+		// must not be reported as one of the others.
+		{"BSD-3-Clause.t1", "BSD-3-Clause", 5, false},
+		{"BSD-3-Clause.t1", "BSD-3-Clause", 3, false},
+		{"Apache-1.0.t1", "Apache-1.0", 5, false},
+		{"GPL-3.0.t1", "GPL-3.0", 2, true},
+	} {
+		data, err := ioutil.ReadFile("testdata/" + tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, text, _ := strings.Cut(string(data), "\n\n") // drop test header
+		n := len(text) / tt.frac
+		for _, end := range []int{
+			strings.LastIndex(text[:n], "\n") + 1,
+			strings.LastIndex(text[:n], " ") + 3, // mid-word
+		} {
+			cov := Scan([]byte(text[:end]))
+			for _, m := range cov.Match {
+				if m.ID != tt.id {
+					t.Errorf("Scan(1/%d of %s, %d bytes) = %+v, want no %s match", tt.frac, tt.id, end, m, m.ID)
+				}
+			}
+			if have := len(cov.Match) == 1 && cov.Match[0].TruncatedAtEnd; have != tt.want {
+				t.Errorf("Scan(1/%d of %s, %d bytes) = %+v, want truncated match %v", tt.frac, tt.id, end, cov.Match, tt.want)
+			}
+		}
+	}
+}
+
+func TestRarityWeights(t *testing.T) {
+	ids := []string{"MIT", "ISC", "BSD-2-Clause", "BSD-3-Clause"}
+	raw := newTestScanner(t, ids)
+	weighted := newTestScanner(t, ids, WithRarityWeights(true))
+
+	// Without its copyright line, the MIT text is all license words.
+	mit := license_MIT[strings.Index(license_MIT, "\n\n")+2:]
+	if cov := weighted.Scan([]byte(mit)); cov.Percent != 100 {
+		t.Errorf("weighted Scan(MIT).Percent = %.1f, want 100", cov.Percent)
+	}
+
+	text := []byte(license_MIT + "\nIn addition, the Licensee shall not use the Software for the operation of nuclear facilities or aircraft navigation.\n")
+	rc := raw.Scan(text)
+	wc := weighted.Scan(text)
+	if len(rc.Match) != 1 || len(wc.Match) != 1 || !matchMatch(rc.Match[0], wc.Match[0]) {
+		t.Fatalf("Scan(MIT + rider) matches differ:\nraw      %+v\nweighted %+v", rc.Match, wc.Match)
+	}
+	if wc.Percent >= rc.Percent {
+		t.Errorf("Scan(MIT + rider).Percent = %.1f weighted, %.1f raw, want weighted < raw", wc.Percent, rc.Percent)
+	}
+}
+
+var copyrightYearsTests = []struct {
+	copyright string
+	years     []int
+}{
+	{"", nil},
+	{"Copyright (c) The Authors\n", nil},
+	{"Copyright 2020 The Authors\n", []int{2020}},
+	{"Copyright (c) 2015, 2019-2021 The Authors\n", []int{2015, 2019, 2020, 2021}},
+	{"Copyright © 2012–14 A. Person\n", []int{2012, 2013, 2014}},
+	{"Copyright 2018 A. Person\n\nCopyright 2016, 2018 Other Corp.\n", []int{2016, 2018}},
+	{"Copyright (C) 1998 to 2000 A. Person <a@example.com>\n", []int{1998, 1999, 2000}},
+	{"Copyright 2021-2019 Backwards Inc.\n", []int{2019, 2021}},
+	{"Copyright 2010 Old Corp.\nSee 2020 release notes.\n", []int{2010}},
+}
+
+func TestCopyrightYears(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT"})
+	mit := license_MIT[strings.Index(license_MIT, "\n\n")+2:]
+	for _, tt := range copyrightYearsTests {
+		cov := s.Scan([]byte(tt.copyright + mit))
+		if len(cov.Match) != 1 {
+			t.Errorf("Scan(%q + MIT) = %+v, want one match", tt.copyright, cov.Match)
+			continue
+		}
+		if have := cov.Match[0].CopyrightYears; !reflect.DeepEqual(have, tt.years) {
+			t.Errorf("Scan(%q + MIT).CopyrightYears = %v, want %v", tt.copyright, have, tt.years)
+		}
+	}
+}
+
+func TestSameLicense(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT", "BSD-2-Clause", "Apache-2.0"})
+	mit := license_MIT[strings.Index(license_MIT, "\n\n")+2:]
+	other := "Copyright 2015 Other Corp.\n\n" + strings.ReplaceAll(mit, "\n", "\n\n")
+
+	tests := []struct {
+		a, b string
+		same bool
+	}{
+		{license_MIT, license_MIT, true},
+		{license_MIT, other, true},
+		{license_MIT, license_MIT + "\nSee also the NOTICE file.\n", true},
+		{license_MIT, "This is not a license.\n", false},
+		{"This is not a license.\n", "This is not a license.\n", false},
+		{license_MIT, license_MIT + strings.Repeat("Unrelated text of no legal significance whatsoever.\n", 40), false},
+	}
+	for i, tt := range tests {
+		if same := s.SameLicense([]byte(tt.a), []byte(tt.b)); same != tt.same {
+			t.Errorf("#%d: SameLicense = %v, want %v", i, same, tt.same)
+		}
+	}
+}
+
+func TestUniqueWords(t *testing.T) {
+	readBody := func(file string) []byte {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data[bytes.Index(data, []byte("\n\n"))+2:]
+	}
+	full := readBody("testdata/GPL-2.0.t1")
+	abridged := readBody("testdata/GPL-2.0-Abridged.t1")
+
+	s := newTestScanner(t, []string{"GPL-2.0", "MIT"})
+	u := newTestScanner(t, []string{"GPL-2.0", "MIT"}, WithUniqueWords(true))
+	for _, tt := range []struct {
+		name     string
+		text     []byte
+		min, max float64 // bounds on percent with WithUniqueWords
+	}{
+		{"full", full, 90, 100},
+		{"abridged", abridged, 90, 100},
+		{"MIT", []byte(license_MIT), 0, 20},
+		{"full+MIT", append(append([]byte{}, full...), license_MIT...), 85, 95},
+	} {
+		p, ok := u.Coverage(tt.text, "GPL-2.0")
+		if !ok || p < tt.min || p > tt.max {
+			t.Errorf("unique Coverage(%s, GPL-2.0) = %.1f, %v, want %.0f-%.0f, true", tt.name, p, ok, tt.min, tt.max)
+		}
+	}
+	if p, _ := s.Coverage(abridged, "GPL-2.0"); p > 50 {
+		t.Errorf("Coverage(abridged, GPL-2.0) = %.1f, want < 50", p)
+	}
+	if cov := u.Scan(abridged); cov.Percent != s.Scan(abridged).Percent {
+		t.Errorf("WithUniqueWords changed Scan(abridged).Percent")
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT", "MIT-0", "ISC", "BSD-2-Clause"})
+	mit := license_MIT[strings.Index(license_MIT, "\n\n")+2:]
+	tests := []struct {
+		prefix string
+		ids    []string
+	}{
+		{"", nil},
+		{"Copyright 2020 The Authors\n", nil},
+		{"Permission is hereby granted, free of charge, to any person", []string{"MIT", "MIT-0"}},
+		{"Copyright 2020 The Authors\n\nPermission is hereby granted, free of charge, to any person", []string{"MIT", "MIT-0"}},
+		{mit[:strings.Index(mit, "furnished to do so")+len("furnished to do so")], []string{"MIT", "MIT-0"}},
+		{mit[:strings.Index(mit, "the above copyright notice")+len("the above copyright notice")], []string{"MIT"}},
+		{mit, []string{"MIT"}},
+		{"Permission to use, copy, modify, and/or distribute this software for any purpose", []string{"ISC"}},
+		{"Permission is hereby granted, free of charge, to any person who likes cheese", nil},
+	}
+	for _, tt := range tests {
+		if ids := s.Candidates([]byte(tt.prefix)); !reflect.DeepEqual(ids, tt.ids) {
+			t.Errorf("Candidates(%q) = %q, want %q", tt.prefix, ids, tt.ids)
+		}
+	}
+}
+
+func TestWordRange(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT", "Apache-2.0"})
+	cov := s.Scan([]byte(license_MIT))
+	if len(cov.Match) != 1 {
+		t.Fatalf("Scan(MIT) = %+v, want one match", cov.Match)
+	}
+	min, max, ok := s.WordRange("MIT")
+	if !ok || min >= max || cov.Match[0].Words < min || cov.Match[0].Words > max+maxCopyrightWords {
+		t.Errorf("WordRange(MIT) = %d, %d, %v, want range around %d words", min, max, ok, cov.Match[0].Words)
+	}
+	// Both IDs have short notices as well as license texts,
+	// so only the maximum reflects the length of the license.
+	if amin, amax, _ := s.WordRange("Apache-2.0"); amax <= max || amin >= amax {
+		t.Errorf("WordRange(Apache-2.0) = %d, %d, want longer than MIT's %d, %d", amin, amax, min, max)
+	}
+	if min, max, ok := s.WordRange("ISC"); ok || min != 0 || max != 0 {
+		t.Errorf("WordRange(ISC) = %d, %d, %v, want 0, 0, false", min, max, ok)
+	}
+}
+
+func TestSections(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/GPL-3.0.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gpl := data[bytes.Index(data, []byte("\n\n"))+2:]
+	s := newTestScanner(t, []string{"GPL-3.0", "MIT"})
+
+	all := []string{"Preamble"}
+	for i := 0; i <= 17; i++ {
+		all = append(all, fmt.Sprint(i))
+	}
+	cov := s.Scan(gpl)
+	if len(cov.Match) != 1 || !reflect.DeepEqual(cov.Match[0].Sections, all) {
+		t.Fatalf("Scan(GPL-3.0) = %+v, want one match with sections %q", cov.Match, all)
+	}
+
+	// Cut the text off partway through section 5.
+	i := bytes.Index(gpl, []byte("5. Conveying Modified Source Versions."))
+	if i < 0 {
+		t.Fatal("cannot find section 5")
+	}
+	cut := gpl[:i+bytes.Index(gpl[i:], []byte("\n\n"))+1]
+	cov = s.Scan(cut)
+	if len(cov.Match) != 1 || !cov.Match[0].TruncatedAtEnd || !reflect.DeepEqual(cov.Match[0].Sections, all[:6]) {
+		t.Errorf("Scan(GPL-3.0 through start of section 5) = %+v, want one truncated match with sections %q", cov.Match, all[:6])
+	}
+
+	if cov := s.Scan([]byte(license_MIT)); len(cov.Match) != 1 || cov.Match[0].Sections != nil {
+		t.Errorf("Scan(MIT) = %+v, want one match without sections", cov.Match)
+	}
+}
+
+func TestScanRange(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT", "Apache-2.0"})
+	prefix := "Notes — über alles.\n\n"
+	text := []byte(prefix + license_MIT + "\nThe End.\n")
+	start, end := len(prefix), len(prefix)+len(license_MIT)
+
+	cov := s.ScanRange(text, start, end)
+	want := s.Scan([]byte(license_MIT))
+	if len(cov.Match) != 1 || len(want.Match) != 1 {
+		t.Fatalf("ScanRange: %d matches, Scan: %d matches, want 1 each", len(cov.Match), len(want.Match))
+	}
+	m, w := cov.Match[0], want.Match[0]
+	if m.Start != w.Start+start || m.End != w.End+start {
+		t.Errorf("ScanRange match at %d,%d, want %d,%d", m.Start, m.End, w.Start+start, w.End+start)
+	}
+	if runes := utf8.RuneCountInString(prefix); m.RuneStart != w.RuneStart+runes || m.RuneEnd != w.RuneEnd+runes {
+		t.Errorf("ScanRange match at runes %d,%d, want %d,%d", m.RuneStart, m.RuneEnd, w.RuneStart+runes, w.RuneEnd+runes)
+	}
+	if cov.Percent != want.Percent {
+		t.Errorf("ScanRange Percent = %.1f, want %.1f", cov.Percent, want.Percent)
+	}
+	if h := cov.NormalizedHash(m); h == "" || h != want.NormalizedHash(w) {
+		t.Errorf("ScanRange NormalizedHash = %q, want %q", h, want.NormalizedHash(w))
+	}
+
+	// Out-of-bounds ranges are clamped to text.
+	if c := s.ScanRange(text, -10, len(text)+10); !reflect.DeepEqual(c.Match, s.Scan(text).Match) {
+		t.Errorf("ScanRange(-10, len+10) = %v, want %v", c.Match, s.Scan(text).Match)
+	}
+	for _, r := range [][2]int{{end, start}, {len(text) + 1, len(text) + 5}, {-5, -1}, {start, start}} {
+		if c := s.ScanRange(text, r[0], r[1]); c.Percent != 0 || len(c.Match) != 0 {
+			t.Errorf("ScanRange(%d, %d) = %v, want empty Coverage", r[0], r[1], c)
+		}
+	}
+}
+
+func TestMatchThreshold(t *testing.T) {
+	mit := license_MIT[strings.Index(license_MIT, "\n\n")+2:]
+	i := strings.Index(mit, "the above copyright notice")
+	if i < 0 {
+		t.Fatal("cannot find MIT conditions")
+	}
+	// The first half of MIT, followed by made-up conditions.
+	text := []byte(mit[:i] + "the licensee sends a postcard to the authors every year, and never uses the software on a Tuesday.\n")
+
+	for _, tt := range []struct {
+		opts    []Option
+		partial bool
+	}{
+		{nil, false},
+		{[]Option{WithMatchThreshold(DefaultMatchThreshold)}, false},
+		{[]Option{WithMatchThreshold(0)}, false},
+		{[]Option{WithMatchThreshold(90)}, false},
+		{[]Option{WithMatchThreshold(40)}, true},
+	} {
+		s := newTestScanner(t, []string{"MIT", "BSD-2-Clause", "Apache-2.0"}, tt.opts...)
+		cov := s.Scan(text)
+		if !tt.partial {
+			if len(cov.Match) != 0 {
+				t.Errorf("%d options: Scan = %v, want no matches", len(tt.opts), cov.Match)
+			}
+			continue
+		}
+		if len(cov.Match) != 1 {
+			t.Fatalf("WithMatchThreshold(40): Scan = %v, want one match", cov.Match)
+		}
+		m := cov.Match[0]
+		// The made-up conditions begin with "the", which MIT allows next.
+		if m.ID != "MIT" || m.Complete || m.TruncatedAtEnd || m.Start != 0 || m.End != i+len("the") {
+			t.Errorf("WithMatchThreshold(40): Scan = %+v, want partial MIT match at 0,%d", m, i+len("the"))
+		}
+		if cov.Percent < 40 || cov.Percent > 90 {
+			t.Errorf("WithMatchThreshold(40): Percent = %.1f, want 40-90", cov.Percent)
+		}
+	}
+
+	// A complete license is still reported as complete.
+	s := newTestScanner(t, []string{"MIT", "BSD-2-Clause", "Apache-2.0"}, WithMatchThreshold(40))
+	cov := s.Scan([]byte(license_MIT))
+	if len(cov.Match) != 1 || !cov.Match[0].Complete {
+		t.Errorf("WithMatchThreshold(40): Scan(MIT) = %v, want one complete match", cov.Match)
+	}
+}
+
+func TestUnfilledPlaceholders(t *testing.T) {
+	for _, tt := range []struct {
+		file string
+		want []string
+	}{
+		{"MIT.t46", []string{"<year>", "<copyright holders>"}},
+		{"ISC.t5", []string{"{{ cookiecutter.year }}", "{{ cookiecutter.full_name }}"}},
+		{"BSD-3-Clause.t35", []string{"[year]", "[fullname]", "<organization>", "<COPYRIGHT HOLDER>"}},
+		{"Apache-2.0-Header.t1", []string{"[yyyy]", "[name of owner]"}},
+		{"Apache-2.0-Header.t23", []string{"${year}", "${author}"}},
+		{"MIT.t1", []string{"<YEAR>", "<HOLDER>"}},
+
+		// Placeholders in the license's own instructions are not unfilled.
+		{"Apache-2.0.t1", nil},
+		{"GPL-3.0.t1", nil},
+		{"MIT.t3", nil},
+	} {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", tt.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = data[bytes.Index(data, []byte("\n\n"))+2:]
+		cov := Scan(data)
+		if len(cov.Match) == 0 {
+			t.Errorf("%s: no matches", tt.file)
+			continue
+		}
+		if have := cov.Match[0].UnfilledPlaceholders; !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("%s: UnfilledPlaceholders = %q, want %q", tt.file, have, tt.want)
+		}
+	}
+}
+
+func TestRider(t *testing.T) {
+	for _, tt := range []struct {
+		file string
+		want map[string]string
+	}{
+		{"Apache-2.0-CommonsClause.t1", map[string]string{"CommonsClause": "", "Apache-2.0": "CommonsClause"}},
+		{"CommonsClause.t1", map[string]string{"CommonsClause": ""}},
+		{"Apache-2.0.t2", map[string]string{"Apache-2.0": ""}},
+	} {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", tt.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = data[bytes.Index(data, []byte("\n\n"))+2:]
+		have := make(map[string]string)
+		for _, m := range Scan(data).Match {
+			have[m.ID] = m.Rider
+		}
+		if !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("%s: riders = %v, want %v", tt.file, have, tt.want)
+		}
+	}
+}
+
+func TestFragment(t *testing.T) {
+	for _, tt := range []struct {
+		file     string
+		fragment bool
+	}{
+		{"MIT-Fragment.t1", true},
+		{"MIT-Fragment.t2", true},
+		{"MIT.t3", false},
+	} {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", tt.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = data[bytes.Index(data, []byte("\n\n"))+2:]
+		cov := Scan(data)
+		if len(cov.Match) != 1 {
+			t.Errorf("%s: matches = %+v, want one", tt.file, cov.Match)
+			continue
+		}
+		m := cov.Match[0]
+		if m.ID != "MIT" || m.IsFragment != tt.fragment || m.IsNotice != tt.fragment || m.Complete == tt.fragment {
+			t.Errorf("%s: match = %+v, want MIT with IsFragment=%v", tt.file, m, tt.fragment)
+		}
+	}
+}
+
+func TestLang(t *testing.T) {
+	for _, tt := range []struct {
+		file string
+		want []string
+	}{
+		{"EUPL-1.2-Lang.t1", []string{"de", "es", "fr", "it", "nl", "pt"}},
+		{"EUPL-1.2.t1", []string{""}},
+		{"CECILL-1.0.t1", []string{"fr"}},
+		{"D-FSL-1.0.t1", []string{"de"}},
+	} {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", tt.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = data[bytes.Index(data, []byte("\n\n"))+2:]
+		var have []string
+		for _, m := range Scan(data).Match {
+			have = append(have, m.Lang)
+		}
+		if !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("%s: languages = %q, want %q", tt.file, have, tt.want)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT", "BSD-2-Clause", "Apache-2.0"})
+	bsd, err := ioutil.ReadFile("testdata/BSD-2-Clause.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bsd = bsd[bytes.Index(bsd, []byte("\n\n"))+2:]
+	for _, tt := range []struct {
+		name string
+		text string
+	}{
+		{"MIT", license_MIT},
+		{"MIT then BSD", license_MIT + "\n" + string(bsd)},
+		{"BSD then MIT", string(bsd) + "\n" + license_MIT},
+		{"MIT twice then BSD", license_MIT + "\n" + license_MIT + "\n" + string(bsd)},
+		{"prose", "This is not a license, just some words."},
+		{"empty", ""},
+	} {
+		id, percent := s.Classify([]byte(tt.text))
+		wantID, wantPercent := s.Scan([]byte(tt.text)).Dominant()
+		if id != wantID {
+			t.Errorf("%s: Classify() = %q, %.1f%%, want %q", tt.name, id, percent, wantID)
+			continue
+		}
+		if id == "" && percent != 0 || id != "" && (percent <= 0 || percent > wantPercent) {
+			t.Errorf("%s: Classify() = %q, %.1f%%, want percentage in (0, %.1f%%]", tt.name, id, percent, wantPercent)
+		}
+	}
+}
+
+func TestCollapseRepeats(t *testing.T) {
+	other := strings.Replace(license_MIT, "the right gopher", "another gopher", 1)
+	if other == license_MIT {
+		t.Fatal("cannot find MIT copyright holder")
+	}
+	text := []byte(license_MIT + license_MIT + license_MIT + other + license_MIT)
+
+	plain := newTestScanner(t, []string{"MIT"}).Scan(text)
+	if len(plain.Match) != 5 {
+		t.Fatalf("Scan without WithCollapseRepeats = %d matches, want 5", len(plain.Match))
+	}
+
+	s := newTestScanner(t, []string{"MIT"}, WithCollapseRepeats(true))
+	c := s.Scan(text)
+	if len(c.Match) != 3 {
+		t.Fatalf("Scan = %d matches, want 3:\n%+v", len(c.Match), c.Match)
+	}
+	m := c.Match[0]
+	if m.Repeats != 3 || m.Start != plain.Match[0].Start || m.End != plain.Match[0].End ||
+		m.LastStart != plain.Match[2].Start || m.LastEnd != plain.Match[2].End ||
+		m.Words != 3*plain.Match[0].Words {
+		t.Errorf("Scan collapsed match = %+v, want 3 repeats spanning matches %+v to %+v", m, plain.Match[0], plain.Match[2])
+	}
+	for i, m := range c.Match[1:] {
+		if m.Repeats != 0 || m.Start != plain.Match[3+i].Start {
+			t.Errorf("Scan match #%d = %+v, want %+v", 1+i, m, plain.Match[3+i])
+		}
+	}
+	if c.Percent != plain.Percent {
+		t.Errorf("Scan Percent = %.1f%%, want %.1f%%", c.Percent, plain.Percent)
+	}
+
+	// Offsets of the last copy are shifted by ScanRange too.
+	r := s.ScanRange(append([]byte("prefix\n"), text...), 7, len(text)+7)
+	if len(r.Match) != 3 || r.Match[0].LastStart != m.LastStart+7 || r.Match[0].LastEnd != m.LastEnd+7 {
+		t.Errorf("ScanRange collapsed match = %+v, want last copy at %d,%d", r.Match, m.LastStart+7, m.LastEnd+7)
+	}
+}
+
+func TestUpperCase(t *testing.T) {
+	// Licenses written entirely in capitals match like the originals.
+	for _, file := range []string{
+		"testdata/Apache-2.0.t1",
+		"testdata/GPL-2.0.t1",
+		"testdata/GPL-3.0.t1",
+		"testdata/LGPL-2.1.t1",
+		"testdata/MPL-2.0.t1",
+		"testdata/OSL-1.1.t1",
+		"testdata/AGPL-3.0-Reference.t1",
+	} {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		text := data[bytes.Index(data, []byte("\n\n"))+2:]
+		want := Scan(text)
+		have := Scan(bytes.ToUpper(text))
+		if len(have.Match) != len(want.Match) || have.Percent != want.Percent {
+			t.Errorf("%s: Scan(upper case) = %.1f%% %v, want %.1f%% %v", file, have.Percent, have.Match, want.Percent, want.Match)
+			continue
+		}
+		for i, m := range have.Match {
+			if w := want.Match[i]; m.ID != w.ID || m.Words != w.Words || m.Complete != w.Complete {
+				t.Errorf("%s: Scan(upper case).Match[%d] = %s %d words, want %s %d words", file, i, m.ID, m.Words, w.ID, w.Words)
+			}
+		}
+	}
+}
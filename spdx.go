@@ -0,0 +1,294 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// isParenthesized reports whether the whole of the SPDX expression expr
+// is enclosed in a single pair of parentheses, as in "(MIT OR Apache-2.0)"
+// but not "(MIT OR Apache-2.0) AND (BSD-2-Clause OR ISC)".
+func isParenthesized(expr string) bool {
+	if !strings.HasPrefix(expr, "(") {
+		return false
+	}
+	depth := 0
+	for i, c := range expr {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i == len(expr)-1
+			}
+		}
+	}
+	return false
+}
+
+// nonSPDX lists the license IDs that licensecheck defines beyond
+// the SPDX license list (see licenses/README.md), other than those
+// in orExpressions, which stand for SPDX expressions.
+var nonSPDX = map[string]bool{
+	"AGPL":                     true,
+	"Aladdin-9":                true,
+	"Anti996":                  true,
+	"BSD-1-Clause-Clear":       true,
+	"BSD-3-Clause-NoTrademark": true,
+	"CC-BY-NC-SA-3.0-US":       true,
+	"CommonsClause":            true,
+	"GPL":                      true,
+	"GPL-2.0-HowToApply":       true,
+	"GPL-3.0-HowToApply":       true,
+	"GooglePatentClause":       true,
+	"GooglePatentsFile":        true,
+	"LGPL":                     true,
+	"MIT-NoAd":                 true,
+	"NoLicense":                true,
+	"PDM-1.0":                  true,
+	"Prosperity-3.0.0":         true,
+}
+
+// spdxRef returns the license ID id as written in an SPDX document:
+// LicenseRef-id for an ID that licensecheck defines beyond SPDX,
+// and id itself otherwise.
+func spdxRef(id string) string {
+	if nonSPDX[id] {
+		return "LicenseRef-" + id
+	}
+	return id
+}
+
+// extractedText returns the text of the first match of the license id in c,
+// for the ExtractedText field of an SPDX document. If c was not produced
+// by Scan, so that the text is unknown, it returns a sentence naming the license.
+// The text never contains the </text> that ends the field.
+func (c Coverage) extractedText(id string) string {
+	for _, m := range c.Match {
+		if m.ID == id && c.text != nil && 0 <= m.Start && m.Start < m.End && m.End <= len(c.text) {
+			return strings.ReplaceAll(string(c.text[m.Start:m.End]), "</text>", "</ text>")
+		}
+	}
+	return "Text identified by licensecheck as " + id + "."
+}
+
+// spdxTag is the prefix of an SPDX license tag line.
+const spdxTag = "SPDX-License-Identifier:"
+
+// ScanSPDXTags returns the licenses named by the
+// SPDX-License-Identifier tags in text, such as
+//
+//	// SPDX-License-Identifier: MIT OR Apache-2.0
+//
+// Each license ID in a tag's license expression is reported as a separate
+// Match, in text order, with IsTag set and Start and End giving the location
+// of the ID itself in text. The operators AND, OR, and WITH and any
+// parentheses are not reported, nor are the exception IDs following WITH.
+// The Type of each match is taken from the built-in license set,
+// or Unknown for IDs not in that set.
+//
+// ScanSPDXTags does no license text matching at all,
+// so it is much faster than Scan and needs no Scanner.
+func ScanSPDXTags(text []byte) []Match {
+	var list []Match
+	for off := 0; ; {
+		i := bytes.Index(text[off:], []byte(spdxTag))
+		if i < 0 {
+			break
+		}
+		start := off + i + len(spdxTag)
+		end := bytes.IndexByte(text[start:], '\n')
+		if end < 0 {
+			end = len(text)
+		} else {
+			end += start
+		}
+		list = appendSPDXExpr(list, text, start, end)
+		off = end
+	}
+	setRuneOffsets(text, list)
+	return list
+}
+
+// appendSPDXExpr appends to list the license IDs in the SPDX expression
+// found in text[start:end] and returns the updated list.
+func appendSPDXExpr(list []Match, text []byte, start, end int) []Match {
+	exception := false
+	for i := start; i < end; {
+		c := text[i]
+		if c == ' ' || c == '\t' || c == '\r' || c == '(' || c == ')' {
+			i++
+			continue
+		}
+		if !('A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9') {
+			// Not part of an expression, such as the */ ending a comment.
+			break
+		}
+		j := i
+		for j < end && isSPDXIDByte(text[j]) {
+			j++
+		}
+		id := string(text[i:j])
+		switch {
+		case id == "AND" || id == "OR" || id == "and" || id == "or":
+			// operator
+		case id == "WITH" || id == "with":
+			exception = true
+		case exception:
+			exception = false
+		default:
+			list = append(list, Match{
+				ID:    id,
+				Type:  builtinType(id),
+				Start: i,
+				End:   j,
+				IsTag: true,
+			})
+		}
+		i = j
+	}
+	return list
+}
+
+// isSPDXIDByte reports whether c can appear in an SPDX license ID
+// (including a DocumentRef- prefix and a trailing + meaning "or later").
+func isSPDXIDByte(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+		c == '.' || c == '-' || c == '+' || c == ':'
+}
+
+// builtinType returns the Type of the built-in license with the given ID,
+// or Unknown if there is no such license.
+func builtinType(id string) Type {
+	id = strings.TrimSuffix(id, "+")
+	for _, l := range builtinLREs {
+		if l.ID == id {
+			return l.Type
+		}
+	}
+	return Unknown
+}
+
+// SPDXDocOptions configures the SPDX document written by Coverage.WriteSPDX.
+type SPDXDocOptions struct {
+	DocumentName      string    // DocumentName field (default "licensecheck")
+	DocumentNamespace string    // DocumentNamespace field, a unique URI (required)
+	Creator           string    // Creator field (default "Tool: licensecheck")
+	Created           time.Time // Created field (default time.Now)
+	PackageName       string    // PackageName field (default DocumentName)
+
+	OmitConcluded     bool // omit the PackageLicenseConcluded field
+	OmitInfoFromFiles bool // omit the PackageLicenseInfoFromFiles fields
+}
+
+// WriteSPDX writes to w a minimal SPDX 2.3 tag-value document describing
+// a single package whose license information is given by c.
+// The PackageLicenseConcluded field is c.SPDXExpression(),
+// enclosed in parentheses if it combines several licenses
+// and is not already enclosed in them, and there is one
+// PackageLicenseInfoFromFiles field for each distinct license
+// in c.Match. If c has no matches, both are written as NOASSERTION.
+//
+// A license ID that licensecheck defines for a choice between licenses,
+// such as Perl, is written as the SPDX licenses it stands for.
+// Any other ID that licensecheck defines beyond SPDX (see licenses/README.md),
+// such as NoLicense or CommonsClause, is written as LicenseRef-ID,
+// with an entry in the document's other licensing information
+// giving the license's name and, for a Coverage returned by Scan,
+// the text of its first match.
+func (c Coverage) WriteSPDX(w io.Writer, opts SPDXDocOptions) error {
+	if opts.DocumentNamespace == "" {
+		return errors.New("WriteSPDX: missing DocumentNamespace")
+	}
+	if opts.DocumentName == "" {
+		opts.DocumentName = "licensecheck"
+	}
+	if opts.Creator == "" {
+		opts.Creator = "Tool: licensecheck"
+	}
+	if opts.Created.IsZero() {
+		opts.Created = time.Now()
+	}
+	if opts.PackageName == "" {
+		opts.PackageName = opts.DocumentName
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "SPDXVersion: SPDX-2.3\n")
+	fmt.Fprintf(&b, "DataLicense: CC0-1.0\n")
+	fmt.Fprintf(&b, "SPDXID: SPDXRef-DOCUMENT\n")
+	fmt.Fprintf(&b, "DocumentName: %s\n", opts.DocumentName)
+	fmt.Fprintf(&b, "DocumentNamespace: %s\n", opts.DocumentNamespace)
+	fmt.Fprintf(&b, "Creator: %s\n", opts.Creator)
+	fmt.Fprintf(&b, "Created: %s\n", opts.Created.UTC().Format("2006-01-02T15:04:05Z"))
+	fmt.Fprintf(&b, "\n")
+	fmt.Fprintf(&b, "PackageName: %s\n", opts.PackageName)
+	fmt.Fprintf(&b, "SPDXID: SPDXRef-Package\n")
+	fmt.Fprintf(&b, "PackageDownloadLocation: NOASSERTION\n")
+	ids := c.ids()
+	if !opts.OmitConcluded {
+		refs := make([]string, len(ids))
+		for i, id := range ids {
+			refs[i] = spdxRef(id)
+		}
+		expr := spdxExpression(refs)
+		if expr == "" {
+			expr = "NOASSERTION"
+		} else if strings.Contains(expr, " ") && !isParenthesized(expr) {
+			expr = "(" + expr + ")"
+		}
+		fmt.Fprintf(&b, "PackageLicenseConcluded: %s\n", expr)
+	}
+	if !opts.OmitInfoFromFiles {
+		var infos []string
+		seen := make(map[string]bool)
+		for _, id := range ids {
+			list := []string{spdxRef(id)}
+			if or, ok := orExpressions[id]; ok {
+				list = strings.Split(or, " OR ")
+			}
+			for _, info := range list {
+				if !seen[info] {
+					seen[info] = true
+					infos = append(infos, info)
+				}
+			}
+		}
+		if len(infos) == 0 {
+			infos = []string{"NOASSERTION"}
+		}
+		for _, info := range infos {
+			fmt.Fprintf(&b, "PackageLicenseInfoFromFiles: %s\n", info)
+		}
+	}
+	fmt.Fprintf(&b, "PackageLicenseDeclared: NOASSERTION\n")
+	fmt.Fprintf(&b, "PackageCopyrightText: NOASSERTION\n")
+
+	if !opts.OmitConcluded || !opts.OmitInfoFromFiles {
+		for _, id := range ids {
+			if !nonSPDX[id] {
+				continue
+			}
+			name := builtinScanner.LicenseName(id)
+			if name == "" {
+				name = "NOASSERTION"
+			}
+			fmt.Fprintf(&b, "\n")
+			fmt.Fprintf(&b, "LicenseID: %s\n", spdxRef(id))
+			fmt.Fprintf(&b, "ExtractedText: <text>%s</text>\n", c.extractedText(id))
+			fmt.Fprintf(&b, "LicenseName: %s\n", name)
+		}
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
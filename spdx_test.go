@@ -0,0 +1,164 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+var spdxTagTests = []struct {
+	text string
+	ids  []string
+}{
+	{"", nil},
+	{"// SPDX-License-Identifier: MIT\n", []string{"MIT"}},
+	{"/* SPDX-License-Identifier: MIT OR Apache-2.0 */\n", []string{"MIT", "Apache-2.0"}},
+	{"# SPDX-License-Identifier: (GPL-2.0-only WITH Linux-syscall-note) AND BSD-3-Clause\n", []string{"GPL-2.0-only", "BSD-3-Clause"}},
+	{"<!-- SPDX-License-Identifier: GPL-2.0+ -->", []string{"GPL-2.0+"}},
+	{"SPDX-License-Identifier: LicenseRef-Acme\r\nint x;\nSPDX-License-Identifier: 0BSD", []string{"LicenseRef-Acme", "0BSD"}},
+	{"SPDX-License-Identifier:\nMIT\n", nil},
+	{"License: MIT\n", nil},
+}
+
+func TestScanSPDXTags(t *testing.T) {
+	for _, tt := range spdxTagTests {
+		list := ScanSPDXTags([]byte(tt.text))
+		var ids []string
+		for _, m := range list {
+			ids = append(ids, m.ID)
+			if !m.IsTag {
+				t.Errorf("ScanSPDXTags(%q): match %s has IsTag=false", tt.text, m.ID)
+			}
+			if tt.text[m.Start:m.End] != m.ID {
+				t.Errorf("ScanSPDXTags(%q): match %s at [%d:%d] = %q", tt.text, m.ID, m.Start, m.End, tt.text[m.Start:m.End])
+			}
+		}
+		if len(ids) != len(tt.ids) {
+			t.Errorf("ScanSPDXTags(%q) = %q, want %q", tt.text, ids, tt.ids)
+			continue
+		}
+		for i := range ids {
+			if ids[i] != tt.ids[i] {
+				t.Errorf("ScanSPDXTags(%q) = %q, want %q", tt.text, ids, tt.ids)
+				break
+			}
+		}
+	}
+}
+
+func TestWriteSPDX(t *testing.T) {
+	opts := SPDXDocOptions{
+		DocumentNamespace: "https://example.com/spdx/x",
+		Created:           time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		PackageName:       "x",
+	}
+	var buf bytes.Buffer
+	if err := testCoverage.WriteSPDX(&buf, opts); err != nil {
+		t.Fatal(err)
+	}
+	want := `SPDXVersion: SPDX-2.3
+DataLicense: CC0-1.0
+SPDXID: SPDXRef-DOCUMENT
+DocumentName: licensecheck
+DocumentNamespace: https://example.com/spdx/x
+Creator: Tool: licensecheck
+Created: 2020-01-02T03:04:05Z
+
+PackageName: x
+SPDXID: SPDXRef-Package
+PackageDownloadLocation: NOASSERTION
+PackageLicenseConcluded: (MIT AND Apache-2.0 AND GPL-2.0)
+PackageLicenseInfoFromFiles: MIT
+PackageLicenseInfoFromFiles: Apache-2.0
+PackageLicenseInfoFromFiles: GPL-2.0
+PackageLicenseDeclared: NOASSERTION
+PackageCopyrightText: NOASSERTION
+`
+	if have := buf.String(); have != want {
+		t.Errorf("WriteSPDX:\n%s\nwant:\n%s", have, want)
+	}
+
+	buf.Reset()
+	opts.OmitInfoFromFiles = true
+	if err := (Coverage{}).WriteSPDX(&buf, opts); err != nil {
+		t.Fatal(err)
+	}
+	if have := buf.String(); !strings.Contains(have, "PackageLicenseConcluded: NOASSERTION\n") || strings.Contains(have, "PackageLicenseInfoFromFiles") {
+		t.Errorf("WriteSPDX of empty Coverage with OmitInfoFromFiles:\n%s", have)
+	}
+
+	// A compound expression is parenthesized once.
+	for _, tt := range []struct {
+		ids  []string
+		want string
+	}{
+		{[]string{"MIT"}, "MIT"},
+		{[]string{"Perl"}, "(Artistic-1.0-Perl OR GPL-1.0-or-later)"},
+		{[]string{"MIT", "Perl"}, "(MIT AND (Artistic-1.0-Perl OR GPL-1.0-or-later))"},
+		{[]string{"(MIT OR Apache-2.0)"}, "(MIT OR Apache-2.0)"},
+		{[]string{"(MIT OR Apache-2.0)", "(BSD-2-Clause OR ISC)"}, "((MIT OR Apache-2.0) AND (BSD-2-Clause OR ISC))"},
+	} {
+		var c Coverage
+		for _, id := range tt.ids {
+			c.Match = append(c.Match, Match{ID: id})
+		}
+		buf.Reset()
+		if err := c.WriteSPDX(&buf, SPDXDocOptions{DocumentNamespace: "https://example.com/spdx/x", OmitInfoFromFiles: true}); err != nil {
+			t.Fatal(err)
+		}
+		if want := "PackageLicenseConcluded: " + tt.want + "\n"; !strings.Contains(buf.String(), want) {
+			t.Errorf("WriteSPDX for %v:\n%s\nwant %s", tt.ids, buf.String(), want)
+		}
+	}
+
+	// IDs that licensecheck defines beyond SPDX are written as LicenseRefs.
+	text := "Unauthorized copying of this file, via any medium, is strictly prohibited.\n"
+	c := Scan([]byte(text))
+	c.Match = append(c.Match, Match{ID: "Perl"}, Match{ID: "GPL"})
+	buf.Reset()
+	if err := c.WriteSPDX(&buf, SPDXDocOptions{DocumentNamespace: "https://example.com/spdx/x"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"PackageLicenseConcluded: (LicenseRef-NoLicense AND (Artistic-1.0-Perl OR GPL-1.0-or-later) AND LicenseRef-GPL)\n",
+		"PackageLicenseInfoFromFiles: LicenseRef-NoLicense\n" +
+			"PackageLicenseInfoFromFiles: Artistic-1.0-Perl\n" +
+			"PackageLicenseInfoFromFiles: GPL-1.0-or-later\n" +
+			"PackageLicenseInfoFromFiles: LicenseRef-GPL\n",
+		"\nLicenseID: LicenseRef-NoLicense\nExtractedText: <text>" + text + "</text>\nLicenseName: ",
+		"\nLicenseID: LicenseRef-GPL\nExtractedText: <text>Text identified by licensecheck as GPL.</text>\nLicenseName: ",
+	} {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("WriteSPDX with non-SPDX IDs:\n%s\nwant %q", buf.String(), line)
+		}
+	}
+	if strings.Contains(buf.String(), "LicenseID: LicenseRef-Perl") {
+		t.Errorf("WriteSPDX with Perl:\n%s\nwant no LicenseRef-Perl", buf.String())
+	}
+
+	if err := testCoverage.WriteSPDX(&buf, SPDXDocOptions{}); err == nil {
+		t.Errorf("WriteSPDX without DocumentNamespace succeeded, want error")
+	}
+}
+
+// TestNonSPDX checks that the IDs written as LicenseRefs
+// are all IDs of built-in licenses.
+func TestNonSPDX(t *testing.T) {
+	ids := make(map[string]bool)
+	for _, l := range BuiltinLicenses() {
+		ids[l.ID] = true
+	}
+	for id := range nonSPDX {
+		if !ids[id] {
+			t.Errorf("nonSPDX lists %s, which is not a built-in license ID", id)
+		}
+		if _, ok := orExpressions[id]; ok {
+			t.Errorf("nonSPDX lists %s, which is in orExpressions", id)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+// A LicenseSummary summarizes the scans of the files making up a package.
+type LicenseSummary struct {
+	// Licenses counts the files matching each license ID.
+	Licenses map[string]int
+
+	// Types counts the files matching a license of each type.
+	// A file matching licenses of several types counts once for each type.
+	Types map[Type]int
+
+	// Type is the result of merging the types of all the licenses found.
+	// It is Unknown if no licenses were found or, as with Type.Merge,
+	// if the type of any of them is Unknown.
+	Type Type
+
+	// Expression is an SPDX license expression for the package as a whole,
+	// suitable for the license field of a software bill of materials:
+	// the conjunction (AND) of the distinct licenses found, sorted by ID,
+	// as described for Coverage.SPDXExpression.
+	// A package following the Rust convention of dual licensing,
+	// with the MIT and Apache-2.0 texts in files named LICENSE-MIT
+	// and LICENSE-APACHE in the same directory, offers a choice between
+	// the two licenses, which Expression writes in place of those two files'
+	// licenses as the disjunction "MIT OR Apache-2.0", parenthesized
+	// if there are other licenses. MIT and Apache-2.0 found in any other
+	// files, such as those of vendored code, remain terms of their own.
+	// It is empty if no licenses were found.
+	Expression string
+
+	// Unlicensed lists, in sorted order, the files with no license match.
+	// Attribution files, whose matches have IsNoticeFile set, are neither
+	// listed here nor counted elsewhere in the summary, since the licenses
+	// they name are those of the software a package bundles.
+	Unlicensed []string
+
+	// Conflicts lists, in sorted order, the files that match licenses
+	// of different types, such as MIT and GPL-2.0, so that which
+	// requirements apply to the file depends on which license governs it.
+	Conflicts []string
+}
+
+// SummarizeLicenses summarizes the results of scanning a package's files,
+// such as those returned by scanning each file in a directory tree,
+// keyed by file name.
+func SummarizeLicenses(results map[string]Coverage) LicenseSummary {
+	sum := LicenseSummary{
+		Licenses: make(map[string]int),
+		Types:    make(map[Type]int),
+	}
+	first := true
+	dual := dualMITApacheFiles(results)
+	terms := make(map[string]bool) // terms of the SPDX expression
+	for file, c := range results {
+		if len(c.Match) == 0 {
+			sum.Unlicensed = append(sum.Unlicensed, file)
+			continue
+		}
+		if c.Match[0].IsNoticeFile {
+			// An attribution file names the licenses of bundled software,
+			// not the package's own.
+			continue
+		}
+		types := make(map[Type]bool)
+		for _, id := range c.ids() {
+			sum.Licenses[id]++
+			if dual[file] {
+				terms[dualMITApache] = true
+			} else {
+				terms[id] = true
+			}
+		}
+		for _, m := range c.Match {
+			if !types[m.Type] {
+				types[m.Type] = true
+				sum.Types[m.Type]++
+			}
+			if first {
+				sum.Type, first = m.Type, false
+			} else {
+				sum.Type = sum.Type.Merge(m.Type)
+			}
+		}
+		if len(types) > 1 {
+			sum.Conflicts = append(sum.Conflicts, file)
+		}
+	}
+
+	ids := make([]string, 0, len(terms))
+	for id := range terms {
+		ids = append(ids, id)
+	}
+	sortExpressionIDs(ids)
+	sum.Expression = spdxExpression(ids)
+	sort.Strings(sum.Unlicensed)
+	sort.Strings(sum.Conflicts)
+	return sum
+}
+
+// dualMITApache is the SPDX expression for the Rust convention
+// of offering a choice between the MIT and Apache-2.0 licenses.
+const dualMITApache = "MIT OR Apache-2.0"
+
+// dualMITApacheFiles returns the set of files in results that follow
+// the Rust convention for dual licensing: a file named LICENSE-MIT
+// containing only the MIT license and one named LICENSE-APACHE
+// containing only the Apache-2.0 license, in the same directory.
+// The names are matched ignoring case and any extension,
+// and LICENCE is accepted for LICENSE.
+func dualMITApacheFiles(results map[string]Coverage) map[string]bool {
+	mit := make(map[string]string)    // directory -> LICENSE-MIT file
+	apache := make(map[string]string) // directory -> LICENSE-APACHE file
+	for file, c := range results {
+		dir, name := path.Split(file)
+		name = strings.ToUpper(name)
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[:i]
+		}
+		name = strings.Replace(name, "LICENCE", "LICENSE", 1)
+		ids := c.ids()
+		if len(ids) != 1 {
+			continue
+		}
+		switch {
+		case name == "LICENSE-MIT" && ids[0] == "MIT":
+			mit[dir] = file
+		case name == "LICENSE-APACHE" && ids[0] == "Apache-2.0":
+			apache[dir] = file
+		}
+	}
+	dual := make(map[string]bool)
+	for dir, file := range mit {
+		if other, ok := apache[dir]; ok {
+			dual[file] = true
+			dual[other] = true
+		}
+	}
+	return dual
+}
+
+// sortExpressionIDs sorts ids, the terms of a package's SPDX expression,
+// by license ID, placing the dualMITApache disjunction where Apache-2.0
+// would be, just after Apache-2.0 itself.
+func sortExpressionIDs(ids []string) {
+	key := func(id string) (string, bool) {
+		if id == dualMITApache {
+			return "Apache-2.0", true
+		}
+		return id, false
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ki, di := key(ids[i])
+		kj, dj := key(ids[j])
+		if ki != kj {
+			return ki < kj
+		}
+		return !di && dj
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSummarizeLicenses(t *testing.T) {
+	mit := Coverage{Match: []Match{{ID: "MIT", Type: Notice}}}
+	gpl := Coverage{Match: []Match{{ID: "GPL-2.0", Type: ShareProgram}}}
+	perl := Coverage{Match: []Match{{ID: "Perl", Type: ShareProgram}}}
+	both := Coverage{Match: append(append([]Match{}, mit.Match...), gpl.Match...)}
+	notice := Coverage{Match: []Match{{ID: "BSD-3-Clause", Type: Notice, IsNoticeFile: true}}}
+	results := map[string]Coverage{
+		"LICENSE":     mit,
+		"main.go":     mit,
+		"copying.h":   gpl,
+		"vendor/x.pl": perl,
+		"mixed.c":     both,
+		"empty.go":    {},
+		"README":      {Percent: 0},
+		"NOTICE":      notice,
+	}
+	sum := SummarizeLicenses(results)
+
+	if want := map[string]int{"MIT": 3, "GPL-2.0": 2, "Perl": 1}; !reflect.DeepEqual(sum.Licenses, want) {
+		t.Errorf("Licenses = %v, want %v", sum.Licenses, want)
+	}
+	if want := map[Type]int{Notice: 3, ShareProgram: 3}; !reflect.DeepEqual(sum.Types, want) {
+		t.Errorf("Types = %v, want %v", sum.Types, want)
+	}
+	if sum.Type != ShareProgram {
+		t.Errorf("Type = %v, want %v", sum.Type, ShareProgram)
+	}
+	if want := "GPL-2.0 AND MIT AND (Artistic-1.0-Perl OR GPL-1.0-or-later)"; sum.Expression != want {
+		t.Errorf("Expression = %q, want %q", sum.Expression, want)
+	}
+	if want := []string{"README", "empty.go"}; !reflect.DeepEqual(sum.Unlicensed, want) {
+		t.Errorf("Unlicensed = %v, want %v", sum.Unlicensed, want)
+	}
+	if want := []string{"mixed.c"}; !reflect.DeepEqual(sum.Conflicts, want) {
+		t.Errorf("Conflicts = %v, want %v", sum.Conflicts, want)
+	}
+
+	if sum := SummarizeLicenses(nil); sum.Expression != "" || sum.Type != Unknown || len(sum.Licenses) != 0 {
+		t.Errorf("SummarizeLicenses(nil) = %+v, want empty summary", sum)
+	}
+}
+
+func TestSummarizeLicensesDualMITApache(t *testing.T) {
+	data, err := os.ReadFile("testdata/Apache-2.0.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, apache, _ := strings.Cut(string(data), "\n\n") // drop test header
+	readme := "# widget\n\nA crate for making widgets.\n"
+
+	// The layout of a typical crate.
+	results := map[string]Coverage{
+		"LICENSE-MIT":    Scan([]byte(license_MIT)),
+		"LICENSE-APACHE": Scan([]byte(apache)),
+		"README.md":      Scan([]byte(readme)),
+		"src/lib.rs":     {},
+	}
+	if have, want := SummarizeLicenses(results).Expression, "MIT OR Apache-2.0"; have != want {
+		t.Errorf("Expression = %q, want %q", have, want)
+	}
+
+	results["vendor/bsd/LICENSE"] = Coverage{Match: []Match{{ID: "BSD-3-Clause", Type: Notice}}}
+	if have, want := SummarizeLicenses(results).Expression, "(MIT OR Apache-2.0) AND BSD-3-Clause"; have != want {
+		t.Errorf("Expression with BSD = %q, want %q", have, want)
+	}
+
+	// Names are matched ignoring case and extension.
+	results = map[string]Coverage{
+		"licence-mit.md":     results["LICENSE-MIT"],
+		"License-Apache.txt": results["LICENSE-APACHE"],
+	}
+	if have, want := SummarizeLicenses(results).Expression, "MIT OR Apache-2.0"; have != want {
+		t.Errorf("Expression with other names = %q, want %q", have, want)
+	}
+
+	// The files must be in the same directory, and only they
+	// make up the disjunction.
+	mitCov, apacheCov := results["licence-mit.md"], results["License-Apache.txt"]
+	for _, tt := range []struct {
+		results map[string]Coverage
+		want    string
+	}{
+		{map[string]Coverage{"vendor/a/LICENSE-MIT": mitCov, "vendor/b/LICENSE-APACHE": apacheCov}, "Apache-2.0 AND MIT"},
+		{map[string]Coverage{"vendor/a/LICENSE-MIT": mitCov, "vendor/a/LICENSE-APACHE": apacheCov}, "MIT OR Apache-2.0"},
+		{map[string]Coverage{"LICENSE-MIT": mitCov, "LICENSE-APACHE": apacheCov, "vendor/a/LICENSE": mitCov}, "(MIT OR Apache-2.0) AND MIT"},
+		{map[string]Coverage{"LICENSE-MIT": mitCov, "LICENSE-APACHE": apacheCov, "vendor/b/LICENSE": apacheCov}, "Apache-2.0 AND (MIT OR Apache-2.0)"},
+	} {
+		if have := SummarizeLicenses(tt.results).Expression; have != tt.want {
+			t.Errorf("SummarizeLicenses(%v).Expression = %q, want %q", tt.results, have, tt.want)
+		}
+	}
+
+	// Without the convention's file names, both licenses apply.
+	for _, results := range []map[string]Coverage{
+		{"LICENSE": results["licence-mit.md"], "NOTICE": results["License-Apache.txt"]},
+		{"LICENSE-MIT": results["License-Apache.txt"], "LICENSE-APACHE": results["licence-mit.md"]},
+		{"LICENSE-MIT": results["licence-mit.md"]},
+	} {
+		sum := SummarizeLicenses(results)
+		if strings.Contains(sum.Expression, " OR ") {
+			t.Errorf("SummarizeLicenses(%v).Expression = %q, want no disjunction", results, sum.Expression)
+		}
+	}
+}
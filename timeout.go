@@ -0,0 +1,41 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ScanTimeout is like Scan but gives up on a scan that takes longer than d,
+// returning an error that wraps context.DeadlineExceeded,
+// so that callers without a context to pass can still avoid hanging
+// on a pathological input. A d <= 0 fails at once, without scanning.
+//
+// ScanTimeout runs the scan in a new goroutine. A scan cannot be
+// interrupted partway through, so after a timeout that goroutine goes on
+// until the scan is done, then discards its result and exits: it holds
+// no locks and leaks nothing once finished, but it keeps using CPU
+// and a reference to text until then, and the caller must not modify text
+// in the meantime. Callers that face a flood of slow inputs should therefore
+// also limit the input, as with WithMaxTokenLength or WithHeaderScan.
+func (s *Scanner) ScanTimeout(text []byte, d time.Duration) (Coverage, error) {
+	if d <= 0 {
+		return Coverage{}, fmt.Errorf("ScanTimeout: %w", context.DeadlineExceeded)
+	}
+	done := make(chan Coverage, 1) // buffered so the scan can finish after a timeout
+	go func() {
+		done <- s.Scan(text)
+	}()
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case c := <-done:
+		return c, nil
+	case <-timer.C:
+		return Coverage{}, fmt.Errorf("ScanTimeout: %w", context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScanTimeout(t *testing.T) {
+	s := newTestScanner(t, []string{"MIT"})
+	text := []byte("Preamble.\n" + license_MIT)
+	cov, err := s.ScanTimeout(text, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := s.Scan(text); !reflect.DeepEqual(cov, want) {
+		t.Errorf("ScanTimeout = %+v, want %+v", cov, want)
+	}
+
+	big := []byte(strings.Repeat(license_MIT, 1000))
+	for _, d := range []time.Duration{0, -1, time.Nanosecond} {
+		cov, err := s.ScanTimeout(big, d)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("ScanTimeout(%v) error = %v, want %v", d, err, context.DeadlineExceeded)
+		}
+		if len(cov.Match) != 0 {
+			t.Errorf("ScanTimeout(%v) = %+v, want empty Coverage", d, cov)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"sort"
+
+	"github.com/google/licensecheck/internal/match"
+)
+
+// A Token is a word of input text as the Scanner reads it.
+type Token struct {
+	Word  string // lowercase, canonicalized form of the word, such as "copyright" for "©"
+	Start int    // Start offset of word in text
+	End   int    // End offset of word in text
+	Known bool   // whether the word appears in any of the Scanner's licenses
+}
+
+// Tokenize returns the words of text as the Scanner reads them
+// when matching licenses, in order.
+// Words that differ only in case or in the ways the Scanner
+// canonicalizes them, such as "©", "(c)", and "Copr." for "copyright",
+// have the same Word field, and punctuation and markup are omitted.
+func (s *Scanner) Tokenize(text []byte) []Token {
+	var toks []Token
+	s.splitTokens(text, func(t Token) bool {
+		toks = append(toks, t)
+		return true
+	})
+	return toks
+}
+
+// ScanNormalized is like Scan but also returns the normalized form of text:
+// the Word fields of the tokens that Tokenize returns for text,
+// separated by single spaces, as in "copyright 2020 zyzzyva inc permission".
+// Callers that go on to process the normalized text can use it
+// instead of normalizing text again.
+//
+// The offsets in the returned Coverage are in the normalized text,
+// not in text: each match's Start and End, RuneStart and RuneEnd,
+// and, for a collapsed match, LastStart and LastEnd, locate the
+// normalized words of the match, from the start of its first word
+// to the end of its last, without the surrounding spaces.
+// Punctuation, comment markers, and other text that is not part of
+// any word have no place in the normalized text, so a match there begins
+// and ends with a word even where the match in text begins with a comment
+// marker or ends with punctuation. Since the normalized text is ASCII apart from
+// any non-ASCII letters in its words, RuneStart and RuneEnd usually
+// equal Start and End.
+func (s *Scanner) ScanNormalized(text []byte) (Coverage, []byte) {
+	c := s.Scan(text)
+	toks := s.Tokenize(text)
+	var norm []byte
+	lo := make([]int, len(toks)+1) // lo[i] is the offset of toks[i].Word in norm
+	hi := make([]int, len(toks))   // hi[i] is the offset of the end of toks[i].Word in norm
+	for i, t := range toks {
+		if i > 0 {
+			norm = append(norm, ' ')
+		}
+		lo[i] = len(norm)
+		norm = append(norm, t.Word...)
+		hi[i] = len(norm)
+	}
+	lo[len(toks)] = len(norm)
+
+	// start and end map offsets in text of the start and end of a match
+	// to the start of its first word and the end of its last in norm.
+	start := func(off int) int {
+		return lo[sort.Search(len(toks), func(i int) bool { return toks[i].Start >= off })]
+	}
+	end := func(off, start int) int {
+		i := sort.Search(len(toks), func(i int) bool { return toks[i].End > off })
+		if i == 0 || hi[i-1] < start {
+			return start
+		}
+		return hi[i-1]
+	}
+	for i := range c.Match {
+		m := &c.Match[i]
+		m.Start = start(m.Start)
+		m.End = end(m.End, m.Start)
+		if m.Repeats > 0 {
+			m.LastStart = start(m.LastStart)
+			m.LastEnd = end(m.LastEnd, m.LastStart)
+		}
+	}
+	setRuneOffsets(norm, c.Match)
+	c.text = norm
+	return c, norm
+}
+
+// splitTokens calls yield with each token of text in turn
+// until yield returns false.
+func (s *Scanner) splitTokens(text []byte, yield func(Token) bool) {
+	s.initBuiltin()
+	s.re.Dict().SplitFunc(string(text), func(w match.Word, form []byte) bool {
+		return yield(Token{
+			Word:  string(form),
+			Start: int(w.Lo),
+			End:   int(w.Hi),
+			Known: w.ID != match.BadWord,
+		})
+	})
+}
+
+// traceNormalization calls the function set with WithNormalizationTrace
+// with each word of text and its normalized form.
+func (s *Scanner) traceNormalization(text []byte) {
+	s.splitTokens(text, func(t Token) bool {
+		s.opts.normTrace(string(text[t.Start:t.End]), t.Word)
+		return true
+	})
+}
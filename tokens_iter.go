@@ -0,0 +1,18 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package licensecheck
+
+import "iter"
+
+// Tokens returns an iterator over the words of text as the Scanner reads them.
+// It yields the same tokens as Tokenize, but one at a time,
+// without building a slice of them all.
+func (s *Scanner) Tokens(text []byte) iter.Seq[Token] {
+	return func(yield func(Token) bool) {
+		s.splitTokens(text, yield)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+
+package licensecheck
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	text, err := os.ReadFile("testdata/Apache-2.0.t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var have []Token
+	for tok := range builtinScanner.Tokens(text) {
+		have = append(have, tok)
+	}
+	if want := builtinScanner.Tokenize(text); !reflect.DeepEqual(have, want) {
+		t.Errorf("Tokens and Tokenize differ: %d and %d tokens", len(have), len(want))
+	}
+
+	// Once yield returns false, Tokens must stop.
+	n := 0
+	builtinScanner.Tokens(text)(func(Token) bool {
+		n++
+		return n < 3
+	})
+	if n != 3 {
+		t.Errorf("Tokens yielded %d tokens, want 3 before stopping", n)
+	}
+}
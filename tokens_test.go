@@ -0,0 +1,80 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	text := []byte("Copyright (c) 2020 Zyzzyva Inc.\n\nPermission is hereby granted.")
+	want := []Token{
+		{Word: "copyright", Start: 0, End: 9, Known: true},
+		{Word: "2020", Start: 14, End: 18, Known: true},
+		{Word: "zyzzyva", Start: 19, End: 26},
+		{Word: "inc", Start: 27, End: 30, Known: true},
+		{Word: "permission", Start: 33, End: 43, Known: true},
+		{Word: "is", Start: 44, End: 46, Known: true},
+		{Word: "hereby", Start: 47, End: 53, Known: true},
+		{Word: "granted", Start: 54, End: 61, Known: true},
+	}
+	if have := builtinScanner.Tokenize(text); !reflect.DeepEqual(have, want) {
+		t.Errorf("Tokenize:\nhave %+v\nwant %+v", have, want)
+	}
+}
+
+func TestNormalizationTrace(t *testing.T) {
+	var have [][2]string
+	s, err := NewScanner(BuiltinLicenses(), WithSpellingVariants(true), WithNormalizationTrace(func(orig, normalized string) {
+		have = append(have, [2]string{orig, normalized})
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Scan([]byte("(c) These Copies are Licenced; see Section 1. Definitions"))
+	want := [][2]string{
+		{"(c)", "copyright"},
+		{"These", "the"},
+		{"Copies", "copy"},
+		{"are", "is"},
+		{"Licenced", "licensed"},
+		{"see", "see"},
+		{"1", "1"},
+		{"Definitions", "definitions"},
+	}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("trace:\nhave %q\nwant %q", have, want)
+	}
+}
+
+func TestScanNormalized(t *testing.T) {
+	text := []byte("// Some code.\n\n/*\n * " + strings.ReplaceAll(strings.TrimSpace(license_MIT), "\n", "\n * ") + "\n */\n")
+	cov, norm := builtinScanner.ScanNormalized(text)
+	var words []string
+	for _, tok := range builtinScanner.Tokenize(text) {
+		words = append(words, tok.Word)
+	}
+	if want := strings.Join(words, " "); string(norm) != want {
+		t.Errorf("ScanNormalized: normalized text = %q, want %q", norm, want)
+	}
+	if len(cov.Match) != 1 {
+		t.Fatalf("ScanNormalized: %d matches, want 1", len(cov.Match))
+	}
+	m := cov.Match[0]
+	if !strings.HasPrefix(string(norm[m.Start:m.End]), "copyright") || !strings.HasSuffix(string(norm[m.Start:m.End]), "software") {
+		t.Errorf("ScanNormalized: match is %q, want copyright ... software", norm[m.Start:m.End])
+	}
+	if have := string(norm[:m.Start]); !strings.HasPrefix(have, "some code ") || !strings.HasSuffix(have, " ") {
+		t.Errorf("ScanNormalized: text before match = %q, want \"some code ...\" ending in a space", have)
+	}
+	if m.End != len(norm) || m.RuneStart != m.Start || m.RuneEnd != m.End {
+		t.Errorf("ScanNormalized: match at [%d:%d] runes [%d:%d], want [%d:%d] for both", m.Start, m.End, m.RuneStart, m.RuneEnd, m.Start, len(norm))
+	}
+	if have, want := cov.NormalizedHash(m), Scan(text).NormalizedHash(Scan(text).Match[0]); have != want {
+		t.Errorf("ScanNormalized: NormalizedHash = %s, want %s", have, want)
+	}
+}
@@ -15,7 +15,7 @@ func TestTypeString(t *testing.T) {
 	}
 
 	numError := 0
-	for typ := Type(0); typ < Discouraged+100; typ++ {
+	for typ := Type(0); typ < NoGrant+100; typ++ {
 		s := typ.String()
 		ptyp, err := ParseType(s)
 		if err != nil {
@@ -44,6 +44,8 @@ var typeMergeTests = []struct {
 	{Unknown, Discouraged, Unknown},
 	{Notice, NonCommercial, Notice | NonCommercial},
 	{Notice, ShareProgram, ShareProgram},
+	{Unrestricted, NoGrant, NoGrant},
+	{Notice, NoGrant, Notice | NoGrant},
 }
 
 func TestTypeMerge(t *testing.T) {
@@ -64,7 +66,8 @@ func licenseType(id string) Type {
 }
 
 var licenseTypeTests = map[string]Type{
-	"WTFPL": Discouraged,
+	"WTFPL":     Discouraged,
+	"NoLicense": NoGrant,
 }
 
 func TestLicenseType(t *testing.T) {
@@ -29,6 +29,9 @@ var urlTests = []urlTest{
 	{[]string{"MIT", "MIT"}, license_MIT + license_MIT},
 	// There was a bug with a number at EOF. See comments in document.findURLsBetween.
 	{[]string{"CC-BY-NC-ND-2.0"}, "See https://creativecommons.org/licenses/by-nc-nd/2.0"},
+	{[]string{"BSL-1.0"}, "See http://www.boost.org/LICENSE_1_0.txt"},
+	{[]string{"EPL-2.0"}, "The license is at https://www.eclipse.org/legal/epl-2.0."},
+	{[]string{"EPL-1.0"}, "See http://www.eclipse.org/legal/epl-v10.html.\n"},
 }
 
 func TestURLMatch(t *testing.T) {
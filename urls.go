@@ -73,6 +73,9 @@ var builtinURLs = []License{
 	{URL: "opensource.org/licenses/xnet", ID: "Xnet"},
 	{URL: "opensource.org/licenses/zpl-2.0", ID: "ZPL-2.0"},
 	{URL: "www.apache.org/licenses/license-2.0", ID: "Apache-2.0"},
+	{URL: "www.boost.org/license_1_0.txt", ID: "BSL-1.0"},
+	{URL: "www.eclipse.org/legal/epl-2.0", ID: "EPL-2.0"},
+	{URL: "www.eclipse.org/legal/epl-v10.html", ID: "EPL-1.0"},
 	{URL: "www.gnu.org/licenses/agpl.txt", ID: "AGPL-3.0"},
 	// {URL: "www.gnu.org/licenses/autoconf-exception-3.0.html", ID: "GPL-3.0-with-autoconf-exception"},
 	// {URL: "www.gnu.org/licenses/ecos-license.html", ID: "eCos-2.0"},
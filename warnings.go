@@ -0,0 +1,61 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+)
+
+// maxNonText is the percentage of the bytes of the input
+// that can be non-text before inputWarnings reports it.
+const maxNonText = 10
+
+// inputWarnings returns the warnings about text
+// described in WithInputWarnings.
+func inputWarnings(text []byte) []string {
+	var list []string
+	if bytes.HasPrefix(text, []byte("\xEF\xBB\xBF")) || bytes.HasPrefix(text, []byte("\xFE\xFF")) || bytes.HasPrefix(text, []byte("\xFF\xFE")) {
+		list = append(list, "byte order mark")
+	}
+
+	crlf, lf, cr, nonText := 0, 0, 0, 0
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\r' && i+1 < len(text) && text[i+1] == '\n':
+			crlf++
+			i += 2
+			continue
+		case c == '\r':
+			cr++
+		case c == '\n':
+			lf++
+		case c == '\t' || c == '\v' || c == '\f':
+			// spacing
+		case c < ' ' || c == 0x7F:
+			nonText++
+		case c >= utf8.RuneSelf:
+			r, size := utf8.DecodeRune(text[i:])
+			if r == utf8.RuneError && size == 1 {
+				nonText++
+			}
+			i += size
+			continue
+		}
+		i++
+	}
+	if crlf > 0 && lf > 0 {
+		list = append(list, "mixed line endings")
+	}
+	if cr > 0 {
+		list = append(list, "stray carriage returns")
+	}
+	if len(text) > 0 && 100*nonText > maxNonText*len(text) {
+		list = append(list, fmt.Sprintf("%d%% of bytes are not text", 100*nonText/len(text)))
+	}
+	return list
+}
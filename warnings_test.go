@@ -0,0 +1,45 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package licensecheck
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var inputWarningsTests = []struct {
+	text string
+	want []string
+}{
+	{license_MIT, nil},
+	{strings.ReplaceAll(license_MIT, "\n", "\r\n"), nil},
+	{"\xEF\xBB\xBF" + license_MIT, []string{"byte order mark"}},
+	{strings.Replace(license_MIT, "\n", "\r\n", 3), []string{"mixed line endings"}},
+	{strings.Replace(license_MIT, "\n", "\r", 1), []string{"stray carriage returns"}},
+	{"\xFF\xFEM\x00I\x00T\x00\n\x00", []string{"byte order mark", "60% of bytes are not text"}},
+	{"", nil},
+}
+
+func TestInputWarnings(t *testing.T) {
+	for _, tt := range inputWarningsTests {
+		if have := inputWarnings([]byte(tt.text)); !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("inputWarnings(%.20q) = %q, want %q", tt.text, have, tt.want)
+		}
+	}
+
+	text := []byte("\xEF\xBB\xBF" + strings.Replace(license_MIT, "\n", "\r\n", 3))
+	s := newTestScanner(t, []string{"MIT"}, WithInputWarnings(true))
+	cov := s.Scan(text)
+	if want := []string{"byte order mark", "mixed line endings"}; !reflect.DeepEqual(cov.InputWarnings, want) {
+		t.Errorf("Scan: InputWarnings = %q, want %q", cov.InputWarnings, want)
+	}
+	if len(cov.Match) != 1 || cov.Match[0].ID != "MIT" {
+		t.Errorf("Scan: Match = %v, want MIT", cov.Match)
+	}
+	if cov := newTestScanner(t, []string{"MIT"}).Scan(text); cov.InputWarnings != nil {
+		t.Errorf("Scan without WithInputWarnings: InputWarnings = %q, want nil", cov.InputWarnings)
+	}
+}